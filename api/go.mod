@@ -4,6 +4,7 @@ go 1.19
 
 require (
 	github.com/alecthomas/units v0.0.0-20210927113745-59d0afb8317a
+	github.com/antonmedv/expr v1.8.9
 	github.com/bxcodec/faker v2.0.1+incompatible
 	github.com/docker/go-units v0.4.0
 	github.com/google/uuid v1.1.2
@@ -0,0 +1,48 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const KindClusterWorkflowTemplate = "ClusterWorkflowTemplate"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=cwft
+
+// ClusterWorkflowTemplate is a cluster scoped library of templates, which could be referenced by the templates
+// in Workflows of every namespace with type TemplateRef and ClusterScope.
+type ClusterWorkflowTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the templates in the library
+	Spec WorkflowTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterWorkflowTemplateList contains a list of ClusterWorkflowTemplate
+type ClusterWorkflowTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterWorkflowTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterWorkflowTemplate{}, &ClusterWorkflowTemplateList{})
+}
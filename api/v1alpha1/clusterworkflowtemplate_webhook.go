@@ -0,0 +1,49 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	gw "github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
+)
+
+var _ webhook.Validator = &ClusterWorkflowTemplate{}
+
+func (in *ClusterWorkflowTemplate) ValidateCreate() error {
+	allErrs := in.Spec.validate(field.NewPath("spec"))
+	if len(allErrs) > 0 {
+		return errors.New(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+func (in *ClusterWorkflowTemplate) ValidateUpdate(old runtime.Object) error {
+	return in.ValidateCreate()
+}
+
+func (in *ClusterWorkflowTemplate) ValidateDelete() error {
+	return nil
+}
+
+var _ webhook.Defaulter = &ClusterWorkflowTemplate{}
+
+func (in *ClusterWorkflowTemplate) Default() {
+	gw.Default(in)
+}
@@ -122,6 +122,9 @@ const (
 	// Recover means this event is recorded, when we recover the chaos
 	// typically, when we call impl.Recover()
 	Recover RecordEventOperation = "Recover"
+	// Resync means this event is recorded, when we re-sync the injected chaos
+	// typically, when the targets of an injected chaos change
+	Resync RecordEventOperation = "Resync"
 )
 
 // NewRecordEvent is a constructor of RecordEvent in status
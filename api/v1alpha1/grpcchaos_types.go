@@ -0,0 +1,98 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="duration",type=string,JSONPath=`.spec.duration`
+// +chaos-mesh:experiment
+
+// GRPCChaos is the Schema for the GRPCChaos API
+type GRPCChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GRPCChaosSpec   `json:"spec,omitempty"`
+	Status GRPCChaosStatus `json:"status,omitempty"`
+}
+
+var _ InnerObjectWithCustomStatus = (*GRPCChaos)(nil)
+var _ InnerObjectWithSelector = (*GRPCChaos)(nil)
+var _ InnerObject = (*GRPCChaos)(nil)
+
+type GRPCChaosSpec struct {
+	PodSelector `json:",inline"`
+
+	// Target is the object to be selected and injected.
+	// +kubebuilder:validation:Enum=Request;Response
+	Target PodHttpChaosTarget `json:"target"`
+
+	PodGrpcChaosActions `json:",inline"`
+
+	// Port represents the target port to be proxy of.
+	// The port cannot be injected by HTTPChaos at the same time.
+	Port int32 `json:"port,omitempty" webhook:"Port"`
+
+	// Service is a rule to select target by the fully-qualified gRPC service name,
+	// `helloworld.Greeter` for example.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// Method is a rule to select target by the gRPC method name of the service,
+	// `SayHello` for example.
+	// +optional
+	Method *string `json:"method,omitempty"`
+
+	// Metadata is a rule to select target by gRPC metadata,
+	// the request metadata for Request target and the response header metadata for Response target.
+	// The key-value pairs represent metadata key and metadata value pairs.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// TLS is the tls config,
+	// will override PodGrpcChaos if there are multiple GRPCChaos experiments are applied
+	// +optional
+	TLS *PodHttpChaosTLS `json:"tls,omitempty"`
+
+	// Duration represents the duration of the chaos action.
+	// +optional
+	Duration *string `json:"duration,omitempty" webhook:"Duration"`
+
+	// RemoteCluster represents the remote cluster where the chaos will be deployed
+	// +optional
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+}
+
+type GRPCChaosStatus struct {
+	ChaosStatus `json:",inline"`
+
+	// Instances always specifies podgrpcchaos generation or empty
+	// +optional
+	Instances map[string]int64 `json:"instances,omitempty"`
+}
+
+func (obj *GRPCChaos) GetSelectorSpecs() map[string]interface{} {
+	return map[string]interface{}{
+		".": &obj.Spec.PodSelector,
+	}
+}
+
+func (obj *GRPCChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
+)
+
+type GrpcStatusCode int32
+
+func (in *GrpcStatusCode) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	// 0 is OK, and 16 is UNAUTHENTICATED, which is the last canonical code
+	if *in <= 0 || *in > 16 {
+		allErrs = append(allErrs, field.Invalid(path, in, fmt.Sprintf("grpc status code %d is not supported", *in)))
+	}
+	return allErrs
+}
+
+func (in *GRPCChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.Service != nil && strings.ContainsAny(*in.Service, "/ ") {
+		allErrs = append(allErrs, field.Invalid(path.Child("service"), in.Service,
+			fmt.Sprintf("service %s should be a fully-qualified service name", *in.Service)))
+	}
+
+	if in.Method != nil {
+		if in.Service == nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("method"), in.Method, "method should be set with service"))
+		}
+		if strings.ContainsAny(*in.Method, "/ ") {
+			allErrs = append(allErrs, field.Invalid(path.Child("method"), in.Method,
+				fmt.Sprintf("method %s should not contain the service name", *in.Method)))
+		}
+	}
+
+	if in.Abort == nil && in.Delay == nil && in.Replace == nil {
+		allErrs = append(allErrs, field.Invalid(path, in, "at least one of abort, delay or replace should be set"))
+	}
+
+	return allErrs
+}
+
+func init() {
+	genericwebhook.Register("GrpcStatusCode", reflect.PtrTo(reflect.TypeOf(GrpcStatusCode(0))))
+}
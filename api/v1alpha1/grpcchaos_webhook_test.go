@@ -0,0 +1,315 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("GRPCChaos Webhook", func() {
+	Context("webhook.Validator of grpcchaos", func() {
+		It("Validate", func() {
+			type TestCase struct {
+				name    string
+				chaos   GRPCChaos
+				execute func(chaos *GRPCChaos) error
+				expect  string
+			}
+			service := "helloworld.Greeter"
+			errorService := "/helloworld.Greeter"
+			method := "SayHello"
+			errorMethod := "helloworld.Greeter/SayHello"
+			validDelay := "1s"
+			errorDelay := "1"
+
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpResponse,
+							Port:   50051,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Abort: &PodGrpcChaosAbortAction{Code: 14},
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpRequest,
+							Port:   50051,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Delay: &validDelay,
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateUpdate(chaos)
+					},
+					expect: "",
+				},
+				{
+					name: "without actions",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpRequest,
+							Port:   50051,
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate port",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpRequest,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Delay: &validDelay,
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid status code",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpResponse,
+							Port:   50051,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Abort: &PodGrpcChaosAbortAction{Code: 17},
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "abort with status code OK",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpResponse,
+							Port:   50051,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Abort: &PodGrpcChaosAbortAction{Code: 0},
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "valid service and method",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: GRPCChaosSpec{
+							Target:  PodHttpResponse,
+							Port:    50051,
+							Service: &service,
+							Method:  &method,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Abort: &PodGrpcChaosAbortAction{Code: 14, Message: "unavailable"},
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "ok",
+				},
+				{
+					name: "invalid service",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: GRPCChaosSpec{
+							Target:  PodHttpRequest,
+							Port:    50051,
+							Service: &errorService,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Delay: &validDelay,
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid method",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: GRPCChaosSpec{
+							Target:  PodHttpRequest,
+							Port:    50051,
+							Service: &service,
+							Method:  &errorMethod,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Delay: &validDelay,
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "method without service",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpRequest,
+							Port:   50051,
+							Method: &method,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Delay: &validDelay,
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid delay",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpRequest,
+							Port:   50051,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Delay: &errorDelay,
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "abort request",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpRequest,
+							Port:   50051,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Abort: &PodGrpcChaosAbortAction{Code: 14},
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "replace message",
+					chaos: GRPCChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: GRPCChaosSpec{
+							Target: PodHttpResponse,
+							Port:   50051,
+							PodGrpcChaosActions: PodGrpcChaosActions{
+								Replace: &PodGrpcChaosReplaceActions{Message: []byte{0x0a, 0x01, 0x61}},
+							},
+						},
+					},
+					execute: func(chaos *GRPCChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	})
+})
@@ -46,6 +46,7 @@ type HTTPChaosSpec struct {
 	PodHttpChaosActions `json:",inline"`
 
 	// Port represents the target port to be proxy of.
+	// The port cannot be injected by GRPCChaos at the same time.
 	Port int32 `json:"port,omitempty" webhook:"Port"`
 
 	// Path is a rule to select target by uri path in http request.
@@ -125,11 +125,51 @@ type NetworkChaosSpec struct {
 	// +optional
 	ExternalTargets []string `json:"externalTargets,omitempty"`
 
+	// TargetServices represents the Kubernetes services as network targets.
+	// Both the cluster IP and the current endpoints of each service are affected,
+	// and they are kept updated during the experiment.
+	// +optional
+	TargetServices []ServiceTarget `json:"targetServices,omitempty"`
+
+	// Protocol represents the protocol of the affected traffic, all the protocols are affected if it's empty.
+	// +optional
+	// +kubebuilder:validation:Enum=tcp;udp;icmp
+	Protocol string `json:"protocol,omitempty"`
+
+	// Ports represents the ports on the target side of the affected traffic,
+	// which are the destination ports of the packets sent by the selected pods.
+	// It requires the protocol to be tcp or udp.
+	// +optional
+	Ports []uint16 `json:"ports,omitempty"`
+
+	// SourcePorts represents the ports on the selected pods' side of the affected traffic,
+	// which are the source ports of the packets sent by the selected pods.
+	// It requires the protocol to be tcp or udp.
+	// +optional
+	SourcePorts []uint16 `json:"sourcePorts,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
 }
 
+// ServiceTarget represents a Kubernetes service as network target
+type ServiceTarget struct {
+	// Name is the name of the service
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the service, the namespace of the chaos is used if it's empty
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Port is the port of the service, all the ports are affected if it's not set.
+	// The cluster IP is affected on this port, and the endpoints are affected on the corresponding target port.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty" webhook:"Port"`
+}
+
 // NetworkChaosStatus defines the observed state of NetworkChaos
 type NetworkChaosStatus struct {
 	ChaosStatus `json:",inline"`
@@ -147,13 +187,69 @@ type DelaySpec struct {
 	Jitter string `json:"jitter,omitempty" default:"0ms" webhook:"Duration"`
 	// +optional
 	Reorder *ReorderSpec `json:"reorder,omitempty"`
+	// Distribution represents the statistical distribution of the jitter,
+	// it's one of normal, pareto, paretonormal, uniform or custom.
+	// A non-zero jitter is required when the distribution is set.
+	// +optional
+	// +kubebuilder:validation:Enum=normal;pareto;paretonormal;uniform;custom
+	Distribution DelayDistribution `json:"distribution,omitempty"`
+	// DistributionTable is the user-supplied distribution table, which is only used with the custom distribution.
+	// The same as the tables generated by `maketable` of iproute2, each value is the scaled offset
+	// `(x - mean) / stddev * 8192` in the range of int16.
+	// +optional
+	DistributionTable []int32 `json:"distributionTable,omitempty"`
 }
 
+// DelayDistribution represents the statistical distribution of the jitter
+type DelayDistribution string
+
+const (
+	// NormalDistribution represents the normal distribution
+	NormalDistribution DelayDistribution = "normal"
+
+	// ParetoDistribution represents the pareto distribution
+	ParetoDistribution DelayDistribution = "pareto"
+
+	// ParetoNormalDistribution represents the combination of pareto and normal distribution
+	ParetoNormalDistribution DelayDistribution = "paretonormal"
+
+	// UniformDistribution represents the uniform distribution, which is the default one of netem
+	UniformDistribution DelayDistribution = "uniform"
+
+	// CustomDistribution represents the distribution described by a user-supplied table
+	CustomDistribution DelayDistribution = "custom"
+)
+
 // LossSpec defines detail of a loss action
 type LossSpec struct {
-	Loss string `json:"loss" webhook:"FloatStr"`
+	// Loss is the percentage of the independent random loss.
+	// It should be zero or omitted when the GEModel is used.
+	// +optional
+	Loss string `json:"loss,omitempty" default:"0" webhook:"FloatStr"`
 	// +optional
 	Correlation string `json:"correlation,omitempty" default:"0" webhook:"FloatStr"`
+	// GEModel represents the Gilbert-Elliott loss model, which is used to simulate burst losses
+	// instead of the independent random loss.
+	// +optional
+	GEModel *LossGEModelSpec `json:"gemodel,omitempty"`
+}
+
+// LossGEModelSpec defines the Gilbert-Elliott loss model.
+// The model has a good state and a bad state, and packets are dropped with different probabilities in each state.
+// All the fields are percentages and provide a number from 0-100.
+type LossGEModelSpec struct {
+	// P is the probability of the transition from the good state to the bad state.
+	P string `json:"p" webhook:"FloatStr"`
+	// R is the probability of the transition from the bad state to the good state.
+	// default: 100 - P.
+	// +optional
+	R *string `json:"r,omitempty" webhook:"FloatStr"`
+	// BadLoss is the loss probability in the bad state, which is `1-h` in the model.
+	// +optional
+	BadLoss string `json:"badLoss,omitempty" default:"100" webhook:"FloatStr"`
+	// GoodLoss is the loss probability in the good state, which is `1-k` in the model.
+	// +optional
+	GoodLoss string `json:"goodLoss,omitempty" default:"0" webhook:"FloatStr"`
 }
 
 // DuplicateSpec defines detail of a duplicate action
@@ -17,11 +17,14 @@ package v1alpha1
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
@@ -46,6 +49,17 @@ func (in *NetworkChaosSpec) Default(root interface{}, field *reflect.StructField
 	if idx := strings.Index(x, "@"); idx != -1 {
 		in.Device = x[:idx]
 	}
+
+	metaData, err := meta.Accessor(root)
+	if err != nil {
+		return
+	}
+
+	for i := range in.TargetServices {
+		if in.TargetServices[i].Namespace == "" {
+			in.TargetServices[i].Namespace = metaData.GetNamespace()
+		}
+	}
 }
 
 type Rate string
@@ -93,10 +107,10 @@ func ConvertUnitToBytes(nu string) (uint64, error) {
 
 // ValidateTargets validates externalTargets and Targets
 func (in *NetworkChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
-	allErrs := field.ErrorList{}
+	allErrs := in.validatePorts(path)
 
 	if in.Action == PartitionAction {
-		return nil
+		return allErrs
 	}
 
 	if (in.Direction == From || in.Direction == Both) &&
@@ -106,15 +120,22 @@ func (in *NetworkChaosSpec) Validate(root interface{}, path *field.Path) field.E
 				"external targets cannot be used with `from` and `both` direction in netem action yet"))
 	}
 
+	if (in.Direction == From || in.Direction == Both) &&
+		in.TargetServices != nil && in.Action != PartitionAction {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("direction"), in.Direction,
+				"target services cannot be used with `from` and `both` direction in netem action yet"))
+	}
+
 	if (in.Direction == From || in.Direction == Both) && in.Target == nil {
 		if in.Action != PartitionAction {
 			allErrs = append(allErrs,
 				field.Invalid(path.Child("direction"), in.Direction,
 					"`from` and `both` direction cannot be used when targets is empty in netem action"))
-		} else if in.ExternalTargets == nil {
+		} else if in.ExternalTargets == nil && in.TargetServices == nil {
 			allErrs = append(allErrs,
 				field.Invalid(path.Child("direction"), in.Direction,
-					"`from` and `both` direction cannot be used when targets and external targets are both empty"))
+					"`from` and `both` direction cannot be used when targets, external targets and target services are all empty"))
 		}
 	}
 
@@ -122,6 +143,155 @@ func (in *NetworkChaosSpec) Validate(root interface{}, path *field.Path) field.E
 	return allErrs
 }
 
+const (
+	// MaxPortsCount is the max count of ports accepted by the multiport match of iptables
+	MaxPortsCount = 15
+)
+
+// validatePorts validates the protocol and ports
+func (in *NetworkChaosSpec) validatePorts(path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	portsList := []struct {
+		name  string
+		ports []uint16
+	}{
+		{"ports", in.Ports},
+		{"sourcePorts", in.SourcePorts},
+	}
+	for _, item := range portsList {
+		name, ports := item.name, item.ports
+		if len(ports) == 0 {
+			continue
+		}
+
+		if in.Protocol != "tcp" && in.Protocol != "udp" {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("protocol"), in.Protocol,
+					fmt.Sprintf("%s can only be used with tcp or udp protocol", name)))
+		}
+
+		if len(ports) > MaxPortsCount {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child(name), ports,
+					fmt.Sprintf("the count of %s should not be larger than %d", name, MaxPortsCount)))
+		}
+
+		for i, port := range ports {
+			if port == 0 {
+				allErrs = append(allErrs,
+					field.Invalid(path.Child(name).Index(i), port, "port 0 is not supported"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// Validate validates the ServiceTarget
+func (in *ServiceTarget) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.Name == "" {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("name"), in.Name, "the name of target service is required"))
+	}
+
+	if in.Port != nil && *in.Port > 65535 {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("port"), *in.Port, fmt.Sprintf("port %d is not supported", *in.Port)))
+	}
+
+	return allErrs
+}
+
+const (
+	// MaxDistributionTableSize is the max size of a distribution table accepted by netem
+	MaxDistributionTableSize = 16 * 1024
+)
+
+func (in *DelaySpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.Distribution != "" && in.Distribution != UniformDistribution {
+		jitter, err := time.ParseDuration(in.Jitter)
+		if err == nil && jitter == 0 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("jitter"), in.Jitter,
+					fmt.Sprintf("jitter should be set with the %s distribution", in.Distribution)))
+		}
+	}
+
+	if in.Distribution == CustomDistribution {
+		if len(in.DistributionTable) == 0 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("distributionTable"), in.DistributionTable,
+					"distribution table should be set with the custom distribution"))
+		}
+	} else if len(in.DistributionTable) != 0 {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("distributionTable"), in.DistributionTable,
+				"distribution table can only be used with the custom distribution"))
+	}
+
+	if len(in.DistributionTable) > MaxDistributionTableSize {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("distributionTable"), len(in.DistributionTable),
+				fmt.Sprintf("the size of distribution table should not be larger than %d", MaxDistributionTableSize)))
+	}
+
+	for i, value := range in.DistributionTable {
+		if value < math.MinInt16 || value > math.MaxInt16 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("distributionTable").Index(i), value,
+					"the value of distribution table should be in the range of int16"))
+		}
+	}
+
+	return allErrs
+}
+
+func (in *LossSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.GEModel == nil {
+		return allErrs
+	}
+
+	loss, err := strconv.ParseFloat(in.Loss, 32)
+	if err == nil && loss != 0 {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("loss"), in.Loss,
+				"loss cannot be used with gemodel"))
+	}
+
+	geModelPath := path.Child("gemodel")
+	probabilities := []struct {
+		name  string
+		value string
+	}{
+		{"p", in.GEModel.P},
+		{"badLoss", in.GEModel.BadLoss},
+		{"goodLoss", in.GEModel.GoodLoss},
+	}
+	if in.GEModel.R != nil {
+		probabilities = append(probabilities, struct {
+			name  string
+			value string
+		}{"r", *in.GEModel.R})
+	}
+	for _, probability := range probabilities {
+		percent, err := strconv.ParseFloat(probability.value, 32)
+		if err == nil && (percent < 0 || percent > 100) {
+			allErrs = append(allErrs,
+				field.Invalid(geModelPath.Child(probability.name), probability.value,
+					"the probability should be in 0-100"))
+		}
+	}
+
+	return allErrs
+}
+
 func init() {
 	genericwebhook.Register("Rate", reflect.PtrTo(reflect.TypeOf(Rate(""))))
 }
@@ -46,6 +46,21 @@ var _ = Describe("networkchaos_webhook", func() {
 			Expect(string(networkchaos.Spec.Delay.Correlation)).To(Equal(DefaultCorrelation))
 			Expect(string(networkchaos.Spec.Delay.Jitter)).To(Equal(DefaultJitter))
 		})
+
+		It("set default namespace of target services", func() {
+			networkchaos := &NetworkChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+				Spec: NetworkChaosSpec{
+					TargetServices: []ServiceTarget{
+						{Name: "web"},
+						{Name: "db", Namespace: "storage"},
+					},
+				},
+			}
+			networkchaos.Default()
+			Expect(networkchaos.Spec.TargetServices[0].Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(networkchaos.Spec.TargetServices[1].Namespace).To(Equal("storage"))
+		})
 	})
 	Context("webhook.Validator of networkchaos", func() {
 		It("Validate", func() {
@@ -56,6 +71,9 @@ var _ = Describe("networkchaos_webhook", func() {
 				execute func(chaos *NetworkChaos) error
 				expect  string
 			}
+			validR := "10"
+			servicePort := int32(80)
+			errorServicePort := int32(65536)
 			tcs := []TestCase{
 				{
 					name: "simple ValidateCreate",
@@ -260,6 +278,385 @@ var _ = Describe("networkchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the distribution",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:      "10ms",
+									Correlation:  "0",
+									Jitter:       "5ms",
+									Distribution: ParetoDistribution,
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "ok",
+				},
+				{
+					name: "validate the distribution without jitter",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:      "10ms",
+									Correlation:  "0",
+									Jitter:       "0ms",
+									Distribution: ParetoNormalDistribution,
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the custom distribution without table",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:      "10ms",
+									Correlation:  "0",
+									Jitter:       "5ms",
+									Distribution: CustomDistribution,
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the distribution table without custom distribution",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:           "10ms",
+									Correlation:       "0",
+									Jitter:            "5ms",
+									Distribution:      NormalDistribution,
+									DistributionTable: []int32{-8192, 0, 8192},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the value of distribution table",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:           "10ms",
+									Correlation:       "0",
+									Jitter:            "5ms",
+									Distribution:      CustomDistribution,
+									DistributionTable: []int32{-8192, 0, 65536},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the gemodel",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Loss: &LossSpec{
+									Loss:        "0",
+									Correlation: "0",
+									GEModel: &LossGEModelSpec{
+										P:        "1",
+										R:        &validR,
+										BadLoss:  "100",
+										GoodLoss: "0",
+									},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "ok",
+				},
+				{
+					name: "validate the gemodel with loss",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Loss: &LossSpec{
+									Loss:        "25",
+									Correlation: "0",
+									GEModel: &LossGEModelSpec{
+										P:        "1",
+										BadLoss:  "100",
+										GoodLoss: "0",
+									},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the probability of gemodel",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo20",
+						},
+						Spec: NetworkChaosSpec{
+							TcParameter: TcParameter{
+								Loss: &LossSpec{
+									Loss:        "0",
+									Correlation: "0",
+									GEModel: &LossGEModelSpec{
+										P:        "1",
+										BadLoss:  "101",
+										GoodLoss: "0",
+									},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the ports",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo21",
+						},
+						Spec: NetworkChaosSpec{
+							Action:      PartitionAction,
+							Protocol:    "tcp",
+							Ports:       []uint16{5432},
+							SourcePorts: []uint16{8080, 8081},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "ok",
+				},
+				{
+					name: "validate the ports without protocol",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo22",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							Ports:  []uint16{5432},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the ports with icmp protocol",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo23",
+						},
+						Spec: NetworkChaosSpec{
+							Action:      DelayAction,
+							Protocol:    "icmp",
+							SourcePorts: []uint16{8080},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the zero port",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo24",
+						},
+						Spec: NetworkChaosSpec{
+							Action:   PartitionAction,
+							Protocol: "udp",
+							Ports:    []uint16{0},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "delay to target services",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo25",
+						},
+						Spec: NetworkChaosSpec{
+							Action: DelayAction,
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:     "90ms",
+									Jitter:      "0ms",
+									Correlation: "0",
+								},
+							},
+							TargetServices: []ServiceTarget{
+								{Name: "web", Port: &servicePort},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "ok",
+				},
+				{
+					name: "delay from target services",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo26",
+						},
+						Spec: NetworkChaosSpec{
+							Action:    DelayAction,
+							Direction: From,
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:     "90ms",
+									Jitter:      "0ms",
+									Correlation: "0",
+								},
+							},
+							TargetServices: []ServiceTarget{
+								{Name: "web"},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "partition with both direction and only target services",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo27",
+						},
+						Spec: NetworkChaosSpec{
+							Action:    PartitionAction,
+							Direction: Both,
+							TargetServices: []ServiceTarget{
+								{Name: "web"},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "ok",
+				},
+				{
+					name: "target service without name",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo28",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							TargetServices: []ServiceTarget{
+								{Namespace: "default"},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "target service with invalid port",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo29",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							TargetServices: []ServiceTarget{
+								{Name: "web", Port: &errorServicePort},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
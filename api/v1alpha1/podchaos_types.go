@@ -21,7 +21,7 @@ import (
 
 // +kubebuilder:object:root=true
 // +chaos-mesh:experiment
-// +chaos-mesh:oneshot=in.Spec.Action==PodKillAction || in.Spec.Action==ContainerKillAction
+// +chaos-mesh:oneshot=in.Spec.Action==PodKillAction || in.Spec.Action==ContainerKillAction || in.Spec.Action==ProcessKillAction
 
 // PodChaos is the control script`s spec.
 type PodChaos struct {
@@ -36,6 +36,7 @@ type PodChaos struct {
 	Status PodChaosStatus `json:"status,omitempty"`
 }
 
+var _ InnerObjectWithCustomStatus = (*PodChaos)(nil)
 var _ InnerObjectWithSelector = (*PodChaos)(nil)
 var _ InnerObject = (*PodChaos)(nil)
 
@@ -50,6 +51,14 @@ const (
 	PodFailureAction PodChaosAction = "pod-failure"
 	// ContainerKillAction represents the chaos action of killing the container
 	ContainerKillAction PodChaosAction = "container-kill"
+	// ContainerPauseAction represents the chaos action of pausing the container.
+	// All processes in the container are frozen with the cgroup freezer during the chaos,
+	// so their network connections are kept open.
+	ContainerPauseAction PodChaosAction = "container-pause"
+	// ProcessKillAction represents the chaos action of sending a signal to the processes
+	// matching the name or the command line inside the container.
+	// Containers sharing the pid namespace of the host are refused.
+	ProcessKillAction PodChaosAction = "process-kill"
 )
 
 // PodChaosSpec defines the attributes that a user creates on a chaos experiment about pods.
@@ -57,13 +66,13 @@ type PodChaosSpec struct {
 	ContainerSelector `json:",inline"`
 
 	// Action defines the specific pod chaos action.
-	// Supported action: pod-kill / pod-failure / container-kill
+	// Supported action: pod-kill / pod-failure / container-kill / container-pause / process-kill
 	// Default action: pod-kill
-	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill
+	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill;container-pause;process-kill
 	Action PodChaosAction `json:"action"`
 
 	// Duration represents the duration of the chaos action.
-	// It is required when the action is `PodFailureAction`.
+	// It is required when the action is `PodFailureAction` or `ContainerPauseAction`.
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
 	// such as "300ms", "-1.5h" or "2h45m".
@@ -77,6 +86,24 @@ type PodChaosSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	GracePeriod int64 `json:"gracePeriod,omitempty"`
 
+	// ProcessName is a regular expression matched against the command name of the processes
+	// in the container, which is used in process-kill action.
+	// +optional
+	ProcessName string `json:"processName,omitempty"`
+
+	// ProcessCmdline is a regular expression matched against the command line of the processes
+	// in the container, which is used in process-kill action.
+	// If both ProcessName and ProcessCmdline are set, a process must match both of them.
+	// +optional
+	ProcessCmdline string `json:"processCmdline,omitempty"`
+
+	// Signal is the signal number sent to the processes in process-kill action.
+	// The default value is 9 (SIGKILL).
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	Signal int `json:"signal,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
@@ -85,6 +112,25 @@ type PodChaosSpec struct {
 // PodChaosStatus represents the current status of the chaos experiment about pods.
 type PodChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	// Instances records the details of the injection, keyed by the record id
+	// +optional
+	Instances map[string]PodChaosInstance `json:"instances,omitempty"`
+}
+
+// PodChaosInstance records the details of the injection on a container
+type PodChaosInstance struct {
+	// KilledProcesses are the processes killed in process-kill action
+	// +optional
+	KilledProcesses []KilledProcess `json:"killedProcesses,omitempty"`
+}
+
+// KilledProcess is a process killed in process-kill action
+type KilledProcess struct {
+	// Pid is the pid of the process in the pid namespace of the container
+	Pid int64 `json:"pid"`
+	// Command is the command line of the process
+	Command string `json:"command"`
 }
 
 func (obj *PodChaos) GetSelectorSpecs() map[string]interface{} {
@@ -93,7 +139,7 @@ func (obj *PodChaos) GetSelectorSpecs() map[string]interface{} {
 		return map[string]interface{}{
 			".": &obj.Spec.PodSelector,
 		}
-	case ContainerKillAction:
+	case ContainerKillAction, ContainerPauseAction, ProcessKillAction:
 		return map[string]interface{}{
 			".": &obj.Spec.ContainerSelector,
 		}
@@ -101,3 +147,7 @@ func (obj *PodChaos) GetSelectorSpecs() map[string]interface{} {
 
 	return nil
 }
+
+func (obj *PodChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
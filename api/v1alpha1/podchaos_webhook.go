@@ -16,18 +16,61 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"reflect"
+	"regexp"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
-// validateContainerNames validates the ContainerNames
+// DefaultProcessKillSignal is the default signal sent in process-kill action, which is SIGKILL
+const DefaultProcessKillSignal = 9
+
+func (in *PodChaosSpec) Default(root interface{}, field *reflect.StructField) {
+	if in == nil {
+		return
+	}
+
+	if in.Action == ProcessKillAction && in.Signal == 0 {
+		in.Signal = DefaultProcessKillSignal
+	}
+}
+
+// Validate validates the ContainerNames, the duration of container-pause and the process-kill fields
 func (in *PodChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	if in.Action == ContainerKillAction {
+	if in.Action == ContainerKillAction || in.Action == ContainerPauseAction {
 		if len(in.ContainerSelector.ContainerNames) == 0 {
 			err := errors.Wrapf(errInvalidValue, "the name of container is required on %s action", in.Action)
 			allErrs = append(allErrs, field.Invalid(path.Child("containerNames"), in.ContainerNames, err.Error()))
 		}
 	}
+	// without a duration the paused container would stay frozen until the chaos is deleted
+	if in.Action == ContainerPauseAction && in.Duration == nil {
+		allErrs = append(allErrs, field.Required(path.Child("duration"), fmt.Sprintf("the duration is required on %s action", in.Action)))
+	}
+	if in.Action == ProcessKillAction {
+		allErrs = append(allErrs, in.validateProcessKill(path)...)
+	}
+	return allErrs
+}
+
+func (in *PodChaosSpec) validateProcessKill(path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(in.ProcessName) == 0 && len(in.ProcessCmdline) == 0 {
+		err := errors.Wrapf(errInvalidValue, "one of processName and processCmdline is required on %s action", in.Action)
+		allErrs = append(allErrs, field.Invalid(path.Child("processName"), in.ProcessName, err.Error()))
+	}
+	if _, err := regexp.Compile(in.ProcessName); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("processName"), in.ProcessName, err.Error()))
+	}
+	if _, err := regexp.Compile(in.ProcessCmdline); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("processCmdline"), in.ProcessCmdline, err.Error()))
+	}
+	// zero means the signal is not set, and the default signal will be used
+	if in.Signal < 0 || in.Signal > 64 {
+		allErrs = append(allErrs, field.Invalid(path.Child("signal"), in.Signal, "signal should be in range [1, 64]"))
+	}
 	return allErrs
 }
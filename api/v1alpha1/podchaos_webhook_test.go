@@ -30,9 +30,21 @@ var _ = Describe("podchaos_webhook", func() {
 			podchaos.Default()
 			Expect(podchaos.Spec.Selector.Namespaces[0]).To(Equal(metav1.NamespaceDefault))
 		})
+
+		It("set default signal of process-kill", func() {
+			podchaos := &PodChaos{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault},
+				Spec: PodChaosSpec{
+					Action: ProcessKillAction,
+				},
+			}
+			podchaos.Default()
+			Expect(podchaos.Spec.Signal).To(Equal(DefaultProcessKillSignal))
+		})
 	})
 	Context("webhook.Validator of podchaos", func() {
 		It("Validate", func() {
+			duration := "10s"
 
 			type TestCase struct {
 				name    string
@@ -86,6 +98,130 @@ var _ = Describe("podchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the ContainerNames of ContainerPauseAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: PodChaosSpec{
+							Action: ContainerPauseAction,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the Duration of ContainerPauseAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodChaosSpec{
+							ContainerSelector: ContainerSelector{
+								ContainerNames: []string{"nginx"},
+							},
+							Action: ContainerPauseAction,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple ValidateCreate for ContainerPauseAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodChaosSpec{
+							ContainerSelector: ContainerSelector{
+								ContainerNames: []string{"nginx"},
+							},
+							Action:   ContainerPauseAction,
+							Duration: &duration,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the process patterns of ProcessKillAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: PodChaosSpec{
+							Action: ProcessKillAction,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the invalid process name regexp",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: PodChaosSpec{
+							Action:      ProcessKillAction,
+							ProcessName: "(",
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the invalid signal",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: PodChaosSpec{
+							Action:      ProcessKillAction,
+							ProcessName: "^worker$",
+							Signal:      65,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "simple ValidateCreate for ProcessKillAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: PodChaosSpec{
+							Action:         ProcessKillAction,
+							ProcessCmdline: "python worker.py",
+							Signal:         15,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -0,0 +1,155 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// PodGrpcChaosSpec defines the desired state of PodGrpcChaos.
+type PodGrpcChaosSpec struct {
+	// Rules are a list of injection rule for grpc call.
+	// +optional
+	Rules []PodGrpcChaosRule `json:"rules,omitempty"`
+
+	// TLS is the tls config,
+	// will be override if there are multiple GRPCChaos experiments are applied
+	// +optional
+	TLS *PodHttpChaosTLS `json:"tls,omitempty"`
+}
+
+// PodGrpcChaosStatus defines the actual state of PodGrpcChaos.
+type PodGrpcChaosStatus struct {
+	// Pid represents a running grpc proxy process id.
+	// +optional
+	Pid int64 `json:"pid,omitempty"`
+
+	// StartTime represents the start time of a grpc proxy process.
+	// +optional
+	StartTime int64 `json:"startTime,omitempty"`
+
+	// +optional
+	FailedMessage string `json:"failedMessage,omitempty"`
+
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// PodGrpcChaosRule defines the injection rule for grpc.
+type PodGrpcChaosRule struct {
+	PodGrpcChaosBaseRule `json:",inline"`
+
+	// Source represents the source of current rules
+	Source string `json:"source,omitempty"`
+
+	// Port represents the target port to be proxy of.
+	Port int32 `json:"port"`
+}
+
+// PodGrpcChaosBaseRule defines the injection rule without source and port.
+type PodGrpcChaosBaseRule struct {
+	// Target is the object to be selected and injected, <Request|Response>.
+	Target PodHttpChaosTarget `json:"target"`
+
+	// Selector contains the rules to select target.
+	Selector PodGrpcChaosSelector `json:"selector"`
+
+	// Actions contains rules to inject target.
+	Actions PodGrpcChaosActions `json:"actions"`
+}
+
+type PodGrpcChaosSelector struct {
+	// Port is a rule to select server listening on specific port.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Service is a rule to select target by the fully-qualified gRPC service name.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// Method is a rule to select target by the gRPC method name of the service.
+	// +optional
+	Method *string `json:"method,omitempty"`
+
+	// Metadata is a rule to select target by gRPC metadata.
+	// The key-value pairs represent metadata key and metadata value pairs.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// PodGrpcChaosActions defines possible actions of GrpcChaos.
+type PodGrpcChaosActions struct {
+	// Abort is a rule to terminate a grpc call with the given status.
+	// +optional
+	Abort *PodGrpcChaosAbortAction `json:"abort,omitempty"`
+
+	// Delay represents the delay of the target request/response.
+	// A duration string is a possibly unsigned sequence of
+	// decimal numbers, each with optional fraction and a unit suffix,
+	// such as "300ms", "2h45m".
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	// +optional
+	Delay *string `json:"delay,omitempty" webhook:"Delay"`
+
+	// Replace is a rule to replace some contents in target.
+	// +optional
+	Replace *PodGrpcChaosReplaceActions `json:"replace,omitempty"`
+}
+
+// PodGrpcChaosAbortAction defines the abort action of GrpcChaos.
+type PodGrpcChaosAbortAction struct {
+	// Code is the `grpc-status` returned to the caller, `14` (UNAVAILABLE) for example.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	Code int32 `json:"code" webhook:"GrpcStatusCode"`
+
+	// Message is the `grpc-message` returned to the caller.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PodGrpcChaosReplaceActions defines possible replace-actions of GrpcChaos.
+type PodGrpcChaosReplaceActions struct {
+	// Message is a rule to replace every protobuf-encoded message in target.
+	// +optional
+	Message []byte `json:"message,omitempty"`
+
+	// Metadata is a rule to replace gRPC metadata of target.
+	// The key-value pairs represent metadata key and metadata value pairs.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// +chaos-mesh:base
+// +chaos-mesh:webhook:enableUpdate
+// +kubebuilder:subresource:status
+// PodGrpcChaos is the Schema for the podgrpcchaos API
+type PodGrpcChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PodGrpcChaosSpec   `json:"spec,omitempty"`
+	Status PodGrpcChaosStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PodGrpcChaosList contains a list of PodGrpcChaos
+type PodGrpcChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodGrpcChaos `json:"items"`
+}
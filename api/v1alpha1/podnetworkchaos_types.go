@@ -86,6 +86,12 @@ type RawIPSet struct {
 	// +optional
 	SetNames []string `json:"setNames,omitempty"`
 
+	// The services whose cluster IP and endpoints are resolved as the contents of ipset.
+	// The services without port are available when IPSetType is NetIPSet,
+	// and the ones with port are available when IPSetType is NetPortIPSet.
+	// +optional
+	Services []ServiceTarget `json:"services,omitempty"`
+
 	// The name and namespace of the source network chaos
 	RawRuleSource `json:",inline"`
 }
@@ -127,6 +133,18 @@ type RawIptables struct {
 	// +optional
 	Device string `json:"device,omitempty"`
 
+	// The protocol of the packets to be blocked
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// The source ports of the packets to be blocked, separated by comma
+	// +optional
+	SourcePorts string `json:"sourcePorts,omitempty"`
+
+	// The destination ports of the packets to be blocked, separated by comma
+	// +optional
+	DestinationPorts string `json:"destinationPorts,omitempty"`
+
 	RawRuleSource `json:",inline"`
 }
 
@@ -158,6 +176,18 @@ type RawTrafficControl struct {
 	// Device represents the network device to be affected.
 	// +optional
 	Device string `json:"device,omitempty"`
+
+	// The protocol of the packets to be affected
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// The source ports of the packets to be affected, separated by comma
+	// +optional
+	SourcePorts string `json:"sourcePorts,omitempty"`
+
+	// The destination ports of the packets to be affected, separated by comma
+	// +optional
+	DestinationPorts string `json:"destinationPorts,omitempty"`
 }
 
 // TcParameter represents the parameters for a traffic control chaos
@@ -17,7 +17,12 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -105,37 +110,73 @@ type Stressors struct {
 	// CPUStressor stresses CPU out
 	// +optional
 	CPUStressor *CPUStressor `json:"cpu,omitempty"`
+	// IOStressor stresses I/O out
+	// +optional
+	IOStressor *IOStressor `json:"io,omitempty"`
+	// HDDStressor stresses disk out
+	// +optional
+	HDDStressor *HDDStressor `json:"hdd,omitempty"`
+	// SockStressor stresses network sockets out
+	// +optional
+	SockStressor *SockStressor `json:"sock,omitempty"`
 }
 
-// Normalize the stressors to comply with stress-ng
-func (in *Stressors) Normalize() (cpuStressors string, memoryStressors string, err error) {
+// Normalize the stressors to comply with stress-ng.
+// The ioStressors contains the io, hdd and sock stressors, which run in the stress-ng process
+// with cpuStressors, and the path of hdd stressor is passed to chaos-daemon separately.
+func (in *Stressors) Normalize() (cpuStressors string, memoryStressors string, ioStressors string, err error) {
 	cpuStressors = ""
 	memoryStressors = ""
+	ioStressors = ""
 	err = nil
 
 	if in.MemoryStressor != nil && in.MemoryStressor.Workers != 0 {
 		memoryStressors += fmt.Sprintf(" --workers %d", in.MemoryStressor.Workers)
 
-		if len(in.MemoryStressor.Size) != 0 {
+		// the size is computed by chaos-daemon when LimitPercent is set, so does the growth time
+		// when the memory grows step by step
+		if len(in.MemoryStressor.Size) != 0 && in.MemoryStressor.LimitPercent == nil {
 			memoryStressors += fmt.Sprintf(" --size %s", in.MemoryStressor.Size)
 		}
 
+		if ramp := in.MemoryStressor.Ramp; ramp != nil {
+			if len(ramp.Duration) != 0 {
+				memoryStressors += fmt.Sprintf(" --time %s", ramp.Duration)
+			} else if in.MemoryStressor.LimitPercent == nil {
+				var size int64
+				size, err = units.FromHumanSize(in.MemoryStressor.Size)
+				if err != nil {
+					return
+				}
+				var growthTime time.Duration
+				growthTime, err = ramp.GrowthTime(size)
+				if err != nil {
+					return
+				}
+				memoryStressors += fmt.Sprintf(" --time %s", growthTime)
+			}
+		}
+
 		if in.MemoryStressor.Options != nil {
 			for _, v := range in.MemoryStressor.Options {
 				memoryStressors += fmt.Sprintf(" %v ", v)
 			}
 		}
 	}
-	if in.CPUStressor != nil && in.CPUStressor.Workers != 0 {
+	if in.CPUStressor != nil && (in.CPUStressor.Workers != 0 || in.CPUStressor.QuotaPercent != nil) {
 		// Without these two args, we may not reach the resource limit of pod,
 		// especially when we set cpu workers > 1
 		// More details see: https://github.com/chaos-mesh/chaos-mesh/issues/3100
 		cpuStressors += " --cpu-load-slice 10 --cpu-method sqrt"
-		cpuStressors += fmt.Sprintf(" --cpu %d", in.CPUStressor.Workers)
 
-		if in.CPUStressor.Load != nil {
-			cpuStressors += fmt.Sprintf(" --cpu-load %d",
-				*in.CPUStressor.Load)
+		// the workers and load are computed by chaos-daemon when QuotaPercent is set
+		if in.CPUStressor.QuotaPercent == nil {
+			cpuStressors += fmt.Sprintf(" --cpu %d", in.CPUStressor.Workers)
+
+			if in.CPUStressor.Load != nil {
+				cpuStressors += fmt.Sprintf(" --cpu-load %d",
+					*in.CPUStressor.Load)
+			}
 		}
 
 		if in.CPUStressor.Options != nil {
@@ -144,10 +185,58 @@ func (in *Stressors) Normalize() (cpuStressors string, memoryStressors string, e
 			}
 		}
 	}
+	if in.IOStressor != nil && in.IOStressor.Workers != 0 {
+		ioStressors += fmt.Sprintf(" --io %d", in.IOStressor.Workers)
+
+		for _, v := range in.IOStressor.Options {
+			ioStressors += fmt.Sprintf(" %v ", v)
+		}
+	}
+	if in.HDDStressor != nil && in.HDDStressor.Workers != 0 {
+		ioStressors += fmt.Sprintf(" --hdd %d", in.HDDStressor.Workers)
+
+		if len(in.HDDStressor.Bytes) != 0 {
+			var bytes string
+			bytes, err = normalizeStressngBytes(in.HDDStressor.Bytes)
+			if err != nil {
+				return
+			}
+			ioStressors += fmt.Sprintf(" --hdd-bytes %s", bytes)
+		}
+
+		for _, v := range in.HDDStressor.Options {
+			ioStressors += fmt.Sprintf(" %v ", v)
+		}
+	}
+	if in.SockStressor != nil && in.SockStressor.Workers != 0 {
+		ioStressors += fmt.Sprintf(" --sock %d", in.SockStressor.Workers)
+
+		if in.SockStressor.Port != nil {
+			ioStressors += fmt.Sprintf(" --sock-port %d", *in.SockStressor.Port)
+		}
+
+		for _, v := range in.SockStressor.Options {
+			ioStressors += fmt.Sprintf(" %v ", v)
+		}
+	}
 
 	return
 }
 
+// normalizeStressngBytes converts the human readable size to the bytes accepted by stress-ng,
+// the percentage is kept as it is
+func normalizeStressngBytes(size string) (string, error) {
+	if strings.HasSuffix(size, "%") {
+		return size, nil
+	}
+
+	bytes, err := units.FromHumanSize(size)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(bytes, 10), nil
+}
+
 // Stressor defines common configurations of a stressor
 type Stressor struct {
 	// Workers specifies N workers to apply the stressor.
@@ -166,6 +255,18 @@ type MemoryStressor struct {
 	// +optional
 	Size string `json:"size,omitempty" webhook:"Bytes"`
 
+	// LimitPercent specifies the size as P percent of the memory limit of the container's cgroup,
+	// which is computed by chaos-daemon. It conflicts with Size.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	LimitPercent *int `json:"limitPercent,omitempty"`
+
+	// Ramp specifies how to grow the memory consumption to the size gradually.
+	// The memory is allocated immediately if it's not set.
+	// +optional
+	Ramp *MemoryRamp `json:"ramp,omitempty"`
+
 	// OOMScoreAdj sets the oom_score_adj of the stress process. See `man 5 proc` to know more
 	// about this option.
 	// +kubebuilder:validation:Minimum=-1000
@@ -179,6 +280,45 @@ type MemoryStressor struct {
 	Options []string `json:"options,omitempty"`
 }
 
+// MemoryRamp defines how to grow the memory consumption, either grows to the size over Duration,
+// or grows by Step every Interval.
+type MemoryRamp struct {
+	// Duration specifies the time to grow the memory consumption to the size.
+	// It conflicts with Step and Interval.
+	// +optional
+	Duration string `json:"duration,omitempty" webhook:"Duration"`
+
+	// Step specifies the bytes to grow every Interval, in units of B, KB/KiB, MB/MiB, GB/GiB, TB/TiB.
+	// +optional
+	Step string `json:"step,omitempty"`
+
+	// Interval specifies the interval between two steps.
+	// +optional
+	Interval string `json:"interval,omitempty" webhook:"Duration"`
+}
+
+// GrowthTime returns the time to grow the memory consumption to size bytes
+func (in *MemoryRamp) GrowthTime(size int64) (time.Duration, error) {
+	if len(in.Duration) != 0 {
+		return time.ParseDuration(in.Duration)
+	}
+
+	step, err := units.FromHumanSize(in.Step)
+	if err != nil {
+		return 0, err
+	}
+	if step <= 0 {
+		return 0, errors.New("step should be positive")
+	}
+	interval, err := time.ParseDuration(in.Interval)
+	if err != nil {
+		return 0, err
+	}
+
+	steps := (size + step - 1) / step
+	return time.Duration(steps) * interval, nil
+}
+
 // CPUStressor defines how to stress CPU out
 type CPUStressor struct {
 	Stressor `json:",inline"`
@@ -189,6 +329,58 @@ type CPUStressor struct {
 	// +optional
 	Load *int `json:"load,omitempty"`
 
+	// QuotaPercent specifies the stressors to consume P percent of the cpu quota of the container's
+	// cgroup. The workers and load are computed by chaos-daemon from the quota when it's set, so it
+	// conflicts with Workers and Load.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	QuotaPercent *int `json:"quotaPercent,omitempty"`
+
+	// extend stress-ng options
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// IOStressor defines how to stress I/O out by calling sync continuously
+type IOStressor struct {
+	Stressor `json:",inline"`
+
+	// extend stress-ng options
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// HDDStressor defines how to stress disk out by writing and removing temporary files
+type HDDStressor struct {
+	Stressor `json:",inline"`
+
+	// Path specifies the directory in the target container to write the temporary files.
+	// +kubebuilder:default=/tmp
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Bytes specifies N bytes written per hdd worker, default is 1GB.
+	// One can specify the size as % of free space on the file system or in units of B, KB/KiB,
+	// MB/MiB, GB/GiB, TB/TiB.
+	// +optional
+	Bytes string `json:"bytes,omitempty" webhook:"Bytes"`
+
+	// extend stress-ng options
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// SockStressor defines how to stress network sockets out by sending and receiving data
+type SockStressor struct {
+	Stressor `json:",inline"`
+
+	// Port specifies the start port of the sock workers, every worker uses one port, default is 5000.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int `json:"port,omitempty"`
+
 	// extend stress-ng options
 	// +optional
 	Options []string `json:"options,omitempty"`
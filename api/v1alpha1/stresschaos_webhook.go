@@ -17,6 +17,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"strconv"
 
@@ -43,7 +44,8 @@ func (in *Stressors) Validate(root interface{}, path *field.Path) field.ErrorLis
 		return nil
 	}
 
-	if in.MemoryStressor == nil && in.CPUStressor == nil {
+	if in.MemoryStressor == nil && in.CPUStressor == nil &&
+		in.IOStressor == nil && in.HDDStressor == nil && in.SockStressor == nil {
 		return field.ErrorList{
 			field.Invalid(path, in, "missing stressors"),
 		}
@@ -97,6 +99,97 @@ func (in *Stressor) Validate(parent *field.Path) field.ErrorList {
 	return errs
 }
 
+// Validate validates whether the MemoryStressor is well defined
+func (in *MemoryStressor) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	errs := field.ErrorList{}
+	if in.LimitPercent != nil && len(in.Size) != 0 {
+		errs = append(errs, field.Invalid(path.Child("limitPercent"), *in.LimitPercent, "limitPercent conflicts with size"))
+	}
+	if in.Ramp != nil && len(in.Ramp.Duration) == 0 && in.LimitPercent == nil {
+		// the growth time is computed from the size when the memory grows step by step
+		if _, err := units.FromHumanSize(in.Size); err != nil {
+			errs = append(errs, field.Invalid(path.Child("size"), in.Size,
+				"size should be in units of bytes or limitPercent should be set when the memory grows step by step"))
+		}
+	}
+	return errs
+}
+
+// Validate validates whether the MemoryRamp is well defined
+func (in *MemoryRamp) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	errs := field.ErrorList{}
+	if len(in.Duration) != 0 {
+		if len(in.Step) != 0 || len(in.Interval) != 0 {
+			errs = append(errs, field.Invalid(path.Child("duration"), in.Duration, "duration conflicts with step and interval"))
+		}
+		return errs
+	}
+
+	if step, err := units.FromHumanSize(in.Step); err != nil || step <= 0 {
+		errs = append(errs, field.Invalid(path.Child("step"), in.Step, "step should be a positive size"))
+	}
+	if len(in.Interval) == 0 {
+		errs = append(errs, field.Required(path.Child("interval"), "interval is required with step"))
+	}
+	return errs
+}
+
+// Validate validates whether the CPUStressor is well defined
+func (in *CPUStressor) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	errs := field.ErrorList{}
+	if in.QuotaPercent != nil && (in.Workers != 0 || in.Load != nil) {
+		errs = append(errs, field.Invalid(path.Child("quotaPercent"), *in.QuotaPercent, "quotaPercent conflicts with workers and load"))
+	}
+	return errs
+}
+
+// Validate validates whether the IOStressor is well defined
+func (in *IOStressor) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	return in.Stressor.Validate(path)
+}
+
+// Validate validates whether the HDDStressor is well defined
+func (in *HDDStressor) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	errs := in.Stressor.Validate(path)
+	if len(in.Path) != 0 && !filepath.IsAbs(in.Path) {
+		errs = append(errs, field.Invalid(path.Child("path"), in.Path, "path should be absolute"))
+	}
+	return errs
+}
+
+// Validate validates whether the SockStressor is well defined
+func (in *SockStressor) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	errs := in.Stressor.Validate(path)
+	if in.Port != nil && (*in.Port < 1024 || *in.Port > 65535) {
+		errs = append(errs, field.Invalid(path.Child("port"), *in.Port, "port should be in range [1024, 65535]"))
+	}
+	return errs
+}
+
 func init() {
 	genericwebhook.Register("Bytes", reflect.PtrTo(reflect.TypeOf(Bytes(""))))
 }
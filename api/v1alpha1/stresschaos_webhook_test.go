@@ -45,6 +45,10 @@ var _ = Describe("stresschaos_webhook", func() {
 					Stressor: Stressor{Workers: 1},
 				},
 			}
+			validPort := 5000
+			invalidPort := 80
+			limitPercent := 50
+			quotaPercent := 80
 			tcs := []TestCase{
 				{
 					name: "simple ValidateCreate",
@@ -107,6 +111,310 @@ var _ = Describe("stresschaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "io, hdd and sock stressors",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								IOStressor: &IOStressor{
+									Stressor: Stressor{Workers: 1},
+								},
+								HDDStressor: &HDDStressor{
+									Stressor: Stressor{Workers: 1},
+									Path:     "/data",
+									Bytes:    "10%",
+								},
+								SockStressor: &SockStressor{
+									Stressor: Stressor{Workers: 1},
+									Port:     &validPort,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "missing workers of io stressor",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								IOStressor: &IOStressor{},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "relative path of hdd stressor",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								HDDStressor: &HDDStressor{
+									Stressor: Stressor{Workers: 1},
+									Path:     "data",
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "incorrect bytes of hdd stressor",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								HDDStressor: &HDDStressor{
+									Stressor: Stressor{Workers: 1},
+									Bytes:    "101%",
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "illegal port of sock stressor",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								SockStressor: &SockStressor{
+									Stressor: Stressor{Workers: 1},
+									Port:     &invalidPort,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "memory grows over duration",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor: Stressor{Workers: 1},
+									Size:     "1GB",
+									Ramp:     &MemoryRamp{Duration: "10m"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "memory grows step by step to the limit percent",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor:     Stressor{Workers: 1},
+									LimitPercent: &limitPercent,
+									Ramp:         &MemoryRamp{Step: "100MB", Interval: "1m"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "memory grows step by step to the percent of total memory",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor: Stressor{Workers: 1},
+									Size:     "50%",
+									Ramp:     &MemoryRamp{Step: "100MB", Interval: "1m"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "size conflicts with limit percent",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor:     Stressor{Workers: 1},
+									Size:         "1GB",
+									LimitPercent: &limitPercent,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "duration conflicts with step",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor: Stressor{Workers: 1},
+									Size:     "1GB",
+									Ramp:     &MemoryRamp{Duration: "10m", Step: "100MB"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "missing interval",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor: Stressor{Workers: 1},
+									Size:     "1GB",
+									Ramp:     &MemoryRamp{Step: "100MB"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "cpu stressor with quota percent",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								CPUStressor: &CPUStressor{
+									QuotaPercent: &quotaPercent,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "cpu stressor with quota percent and workers",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								CPUStressor: &CPUStressor{
+									Stressor:     Stressor{Workers: 1},
+									QuotaPercent: &quotaPercent,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "cpu stressor with quota percent and load",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								CPUStressor: &CPUStressor{
+									Load:         &quotaPercent,
+									QuotaPercent: &quotaPercent,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -39,7 +39,9 @@ type TimeChaos struct {
 var _ InnerObjectWithSelector = (*TimeChaos)(nil)
 var _ InnerObject = (*TimeChaos)(nil)
 
-// TimeChaosSpec defines the desired state of TimeChaos
+// TimeChaosSpec defines the desired state of TimeChaos.
+// The processes started in the selected containers after injection are also injected, but they are
+// looked for every second, so the processes which exit within one second may see the real clock.
 type TimeChaosSpec struct {
 	ContainerSelector `json:",inline"`
 
@@ -47,6 +49,13 @@ type TimeChaosSpec struct {
 	// "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
 	TimeOffset string `json:"timeOffset" webhook:"TimeOffset"`
 
+	// ClockDriftRate defines the delta time drifted per second of injected program, so the offset grows
+	// during the experiment. It's a possibly signed sequence of decimal numbers less than one second, such as
+	// "50ms" or "-1.5ms". For example, with timeOffset "0s" and clockDriftRate "50ms", the clock is 3s ahead
+	// after one minute.
+	// +optional
+	ClockDriftRate string `json:"clockDriftRate,omitempty" webhook:"ClockDriftRate"`
+
 	// ClockIds defines all affected clock id
 	// All available options are ["CLOCK_REALTIME","CLOCK_MONOTONIC","CLOCK_PROCESS_CPUTIME_ID","CLOCK_THREAD_CPUTIME_ID",
 	// "CLOCK_MONOTONIC_RAW","CLOCK_REALTIME_COARSE","CLOCK_MONOTONIC_COARSE","CLOCK_BOOTTIME","CLOCK_REALTIME_ALARM",
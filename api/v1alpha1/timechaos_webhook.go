@@ -50,7 +50,34 @@ func (in *TimeOffset) Validate(root interface{}, path *field.Path) field.ErrorLi
 	return allErrs
 }
 
+type ClockDriftRate string
+
+func (in *ClockDriftRate) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(*in) == 0 {
+		return allErrs
+	}
+
+	rate, err := time.ParseDuration(string(*in))
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(path,
+			in,
+			fmt.Sprintf("parse clockDriftRate field error:%s", err)))
+		return allErrs
+	}
+
+	if rate <= -time.Second || rate >= time.Second {
+		allErrs = append(allErrs, field.Invalid(path,
+			in,
+			"clockDriftRate should be less than one second"))
+	}
+
+	return allErrs
+}
+
 func init() {
 	genericwebhook.Register("ClockIds", reflect.PtrTo(reflect.TypeOf(ClockIds{})))
 	genericwebhook.Register("TimeOffset", reflect.PtrTo(reflect.TypeOf(TimeOffset(""))))
+	genericwebhook.Register("ClockDriftRate", reflect.PtrTo(reflect.TypeOf(ClockDriftRate(""))))
 }
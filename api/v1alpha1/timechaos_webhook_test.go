@@ -100,6 +100,57 @@ var _ = Describe("timechaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the clockDriftRate",
+					chaos: TimeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: TimeChaosSpec{
+							TimeOffset:     "0s",
+							ClockDriftRate: "50ms",
+						},
+					},
+					execute: func(chaos *TimeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "",
+				},
+				{
+					name: "validate the invalid clockDriftRate",
+					chaos: TimeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: TimeChaosSpec{
+							TimeOffset:     "0s",
+							ClockDriftRate: "50MS",
+						},
+					},
+					execute: func(chaos *TimeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the too large clockDriftRate",
+					chaos: TimeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: TimeChaosSpec{
+							TimeOffset:     "0s",
+							ClockDriftRate: "-1s",
+						},
+					},
+					execute: func(chaos *TimeChaos) error {
+						return chaos.ValidateCreate()
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
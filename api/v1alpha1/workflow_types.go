@@ -16,6 +16,9 @@
 package v1alpha1
 
 import (
+	"encoding/json"
+	"regexp"
+
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +50,152 @@ const KindWorkflow = "Workflow"
 type WorkflowSpec struct {
 	Entry     string     `json:"entry"`
 	Templates []Template `json:"templates"`
+	// Parameters declares the parameters of the workflow, which could be referenced as
+	// "{{workflow.parameters.<name>}}" in the templates.
+	// +optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+	// Arguments gives the values of the parameters for this workflow.
+	// +optional
+	Arguments []Argument `json:"arguments,omitempty"`
+}
+
+// Parameter declares a parameter of the workflow
+type Parameter struct {
+	Name string `json:"name"`
+	// Default is used when the parameter is not given in the arguments, the parameter is required
+	// if it has no default value.
+	// +optional
+	Default *string `json:"default,omitempty"`
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// Argument gives the value of a parameter
+type Argument struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+const workflowParametersPrefix = "workflow.parameters."
+
+// parameterReference matches the references like "{{workflow.parameters.latency}}" in the templates
+var parameterReference = regexp.MustCompile(`\{\{\s*workflow\.parameters\.([-\w]+)\s*\}\}`)
+
+// ResolveParameters returns the values of all the parameters, which are the arguments or the
+// default values of parameters
+func (in *WorkflowSpec) ResolveParameters() (map[string]string, error) {
+	values := make(map[string]string)
+	for _, parameter := range in.Parameters {
+		if parameter.Default != nil {
+			values[parameter.Name] = *parameter.Default
+		}
+	}
+
+	declared := make(map[string]struct{})
+	for _, parameter := range in.Parameters {
+		declared[parameter.Name] = struct{}{}
+	}
+	for _, argument := range in.Arguments {
+		if _, ok := declared[argument.Name]; !ok {
+			return nil, errors.Errorf("parameter %s is not declared", argument.Name)
+		}
+		values[argument.Name] = argument.Value
+	}
+
+	for _, parameter := range in.Parameters {
+		if _, ok := values[parameter.Name]; !ok {
+			return nil, errors.Errorf("parameter %s is required", parameter.Name)
+		}
+	}
+	return values, nil
+}
+
+// outputReference matches the references like "{{nodes.find-leader.outputs.stdout}}" in the templates,
+// the first group is the name of the referenced template and the second one is the key of the output
+var outputReference = regexp.MustCompile(`\{\{\s*nodes\.([-\w]+)\.outputs\.([-\w]+)\s*\}\}`)
+
+// RenderTemplate substitutes the references of parameters in the template with their values
+func RenderTemplate(template Template, parameters map[string]string) (Template, error) {
+	return renderReferences(template, parameterReference, func(groups []string) (string, error) {
+		value, ok := parameters[groups[1]]
+		if !ok {
+			return "", errors.Errorf("%s%s is not declared", workflowParametersPrefix, groups[1])
+		}
+		return value, nil
+	})
+}
+
+// RenderOutputs substitutes the references of outputs in the template with the outputs of the finished
+// nodes, the outputs are indexed by the template name of the node
+func RenderOutputs(template Template, outputs map[string]map[string]string) (Template, error) {
+	return renderReferences(template, outputReference, func(groups []string) (string, error) {
+		nodeOutputs, ok := outputs[groups[1]]
+		if !ok {
+			return "", errors.Errorf("outputs of node %s are not available", groups[1])
+		}
+		value, ok := nodeOutputs[groups[2]]
+		if !ok {
+			return "", errors.Errorf("node %s has no output %s", groups[1], groups[2])
+		}
+		return value, nil
+	})
+}
+
+// OutputReferences returns the names of the templates whose outputs are referenced by the template
+func OutputReferences(template Template) ([]string, error) {
+	raw, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	seen := make(map[string]struct{})
+	for _, groups := range outputReference.FindAllSubmatch(raw, -1) {
+		name := string(groups[1])
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func renderReferences(template Template, reference *regexp.Regexp, resolve func(groups []string) (string, error)) (Template, error) {
+	raw, err := json.Marshal(template)
+	if err != nil {
+		return template, err
+	}
+
+	var renderErr error
+	rendered := reference.ReplaceAllFunc(raw, func(matched []byte) []byte {
+		var groups []string
+		for _, group := range reference.FindSubmatch(matched) {
+			groups = append(groups, string(group))
+		}
+		value, err := resolve(groups)
+		if err != nil {
+			renderErr = err
+			return matched
+		}
+
+		// the reference is always inside a json string, so the value should be escaped
+		escaped, err := json.Marshal(value)
+		if err != nil {
+			renderErr = err
+			return matched
+		}
+		return escaped[1 : len(escaped)-1]
+	})
+	if renderErr != nil {
+		return template, renderErr
+	}
+
+	var result Template
+	if err := json.Unmarshal(rendered, &result); err != nil {
+		return template, err
+	}
+	return result, nil
 }
 
 type WorkflowStatus struct {
@@ -86,6 +235,8 @@ const (
 	TypeSuspend     TemplateType = "Suspend"
 	TypeSchedule    TemplateType = "Schedule"
 	TypeStatusCheck TemplateType = "StatusCheck"
+	TypeTemplateRef TemplateType = "TemplateRef"
+	TypeLoop        TemplateType = "Loop"
 )
 
 func IsChaosTemplateType(target TemplateType) bool {
@@ -109,9 +260,13 @@ type Template struct {
 	// Task describes the behavior of the custom task. Only used when Type is TypeTask.
 	// +optional
 	Task *Task `json:"task,omitempty"`
-	// Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.
+	// Children describes the children steps of serial, parallel or loop node. Only used when Type is TypeSerial,
+	// TypeParallel or TypeLoop.
 	// +optional
 	Children []string `json:"children,omitempty"`
+	// Loop describes how many times the children of loop node are repeated. Only used when Type is TypeLoop.
+	// +optional
+	Loop *LoopSpec `json:"loop,omitempty"`
 	// ConditionalBranches describes the conditional branches of custom tasks. Only used when Type is TypeTask.
 	// +optional
 	ConditionalBranches []ConditionalBranch `json:"conditionalBranches,omitempty"`
@@ -128,6 +283,52 @@ type Template struct {
 	// Only used when Type is TypeStatusCheck.
 	// +optional
 	AbortWithStatusCheck bool `json:"abortWithStatusCheck,omitempty"`
+	// TemplateRef references a template in a WorkflowTemplate, which is resolved when the workflow starts.
+	// Only used when Type is TypeTemplateRef.
+	// +optional
+	TemplateRef *TemplateRef `json:"templateRef,omitempty"`
+	// RetryStrategy describes how to retry the node when it fails. Only used when Type is TypeTask, TypeSchedule
+	// or Type<Something>Chaos.
+	// +optional
+	RetryStrategy *RetryStrategy `json:"retryStrategy,omitempty"`
+	// OnFailure is the name of the template to spawn once the node fails and there are no more retries,
+	// a Serial or Parallel node fails once any of its children fails.
+	// Only used when Type is TypeTask, TypeSchedule, TypeSerial, TypeParallel or Type<Something>Chaos.
+	// +optional
+	OnFailure string `json:"onFailure,omitempty"`
+	// OnExit is the name of the template to spawn once the node finishes, no matter whether it fails.
+	// Only used when Type is TypeTask, TypeSchedule, TypeSerial, TypeParallel or Type<Something>Chaos.
+	// +optional
+	OnExit string `json:"onExit,omitempty"`
+}
+
+// RetryStrategy describes the retries of a failed node. A chaos node fails when the chaos could not be created,
+// and a task node fails when the container of the task exits with non-zero code.
+type RetryStrategy struct {
+	// Limit is the max number of retries.
+	// +kubebuilder:validation:Minimum=0
+	Limit int `json:"limit"`
+	// Backoff is the duration to wait before the first retry, and it is doubled for each of the following retries.
+	// +optional
+	Backoff *string `json:"backoff,omitempty"`
+}
+
+// LoopSpec describes the repetition of the children of a loop node. The children are executed serially in each
+// iteration, and the loop stops when Count iterations are finished or the Until expression is evaluated as true.
+type LoopSpec struct {
+	// Count is the max number of iterations.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count *int `json:"count,omitempty"`
+	// Until is the expression evaluated after each iteration, expected type of result is boolean. The loop
+	// stops once it is evaluated as true. The number of finished iterations is available as "iteration", and
+	// the context of the last Task in the iteration (like "exitCode" and "stdout") is also available. The loop
+	// node fails if the expression cannot be evaluated.
+	// +optional
+	Until string `json:"until,omitempty"`
+	// Interval is the delay between two iterations.
+	// +optional
+	Interval *string `json:"interval,omitempty"`
 }
 
 // ChaosOnlyScheduleSpec is very similar with ScheduleSpec, but it could not schedule Workflow
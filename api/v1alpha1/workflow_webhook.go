@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"time"
 
+	"github.com/antonmedv/expr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -39,7 +41,11 @@ func (in *Workflow) ValidateCreate() error {
 	var allErrs field.ErrorList
 	specPath := field.NewPath("spec")
 	allErrs = append(allErrs, entryMustExists(specPath.Child("entry"), in.Spec.Entry, in.Spec.Templates)...)
-	allErrs = append(allErrs, validateTemplates(specPath.Child("templates"), in.Spec.Templates)...)
+	allErrs = append(allErrs, validateParameters(specPath.Child("parameters"), in.Spec.Parameters)...)
+	// the templates are validated after the parameters are substituted, like what the controller does
+	templates, errs := renderTemplates(specPath, in.Spec)
+	allErrs = append(allErrs, errs...)
+	allErrs = append(allErrs, validateTemplates(specPath.Child("templates"), templates)...)
 	if len(allErrs) > 0 {
 		return errors.New(allErrs.ToAggregate().Error())
 	}
@@ -73,6 +79,45 @@ func entryMustExists(path *field.Path, entry string, templates []Template) field
 	return result
 }
 
+func validateParameters(path *field.Path, parameters []Parameter) field.ErrorList {
+	var result field.ErrorList
+	names := make(map[string]struct{})
+	for i, parameter := range parameters {
+		if len(parameter.Name) == 0 {
+			result = append(result, field.Required(path.Index(i).Child("name"), "name of parameter is required"))
+			continue
+		}
+		if _, ok := names[parameter.Name]; ok {
+			result = append(result, field.Duplicate(path.Index(i).Child("name"), parameter.Name))
+		}
+		names[parameter.Name] = struct{}{}
+	}
+	return result
+}
+
+// renderTemplates substitutes the parameters in the templates, the original templates are returned if
+// the parameters could not be resolved
+func renderTemplates(specPath *field.Path, spec WorkflowSpec) ([]Template, field.ErrorList) {
+	parameters, err := spec.ResolveParameters()
+	if err != nil {
+		return spec.Templates, field.ErrorList{
+			field.Invalid(specPath.Child("arguments"), spec.Arguments, err.Error()),
+		}
+	}
+
+	var result field.ErrorList
+	var templates []Template
+	for i, template := range spec.Templates {
+		rendered, err := RenderTemplate(template, parameters)
+		if err != nil {
+			result = append(result, field.Invalid(specPath.Child("templates").Index(i), template.Name, err.Error()))
+			rendered = template
+		}
+		templates = append(templates, rendered)
+	}
+	return templates, result
+}
+
 func validateTemplates(path *field.Path, templates []Template) field.ErrorList {
 	var result field.ErrorList
 	if len(templates) == 0 {
@@ -88,6 +133,7 @@ func validateTemplates(path *field.Path, templates []Template) field.ErrorList {
 	for i, item := range templates {
 		itemPath := path.Index(i)
 		result = append(result, validateTemplate(itemPath, item, templates)...)
+		result = append(result, outputReferencesMustExist(itemPath, item, templates)...)
 	}
 	return result
 }
@@ -117,6 +163,7 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoFailurePolicy(path, template)...)
 	case templateType == TypeSerial, templateType == TypeParallel:
 		for i, item := range template.Children {
 			result = append(result, templateMustExists(item, path.Child("children").Index(i), allTemplates)...)
@@ -125,15 +172,19 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoRetryStrategy(path, template)...)
+		result = append(result, validateHooks(path, template, allTemplates)...)
 	case templateType == TypeSchedule:
 		result = append(result, shouldBeNoTask(path, template)...)
 		result = append(result, shouldBeNoChildren(path, template)...)
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, validateFailurePolicy(path, template, allTemplates)...)
 	case templateType == TypeTask:
 		result = append(result, shouldBeNoChildren(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, validateFailurePolicy(path, template, allTemplates)...)
 	case IsChaosTemplateType(templateType):
 		result = append(result, shouldNotSetupDurationInTheChaos(path, template)...)
 
@@ -143,12 +194,36 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 		result = append(result, shouldBeNoSchedule(path, template)...)
 
 		result = append(result, template.EmbedChaos.Validate(path, string(templateType))...)
+		result = append(result, validateFailurePolicy(path, template, allTemplates)...)
 	case templateType == TypeStatusCheck:
 		result = append(result, shouldBeNoTask(path, template)...)
 		result = append(result, shouldBeNoChildren(path, template)...)
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoFailurePolicy(path, template)...)
+	case templateType == TypeLoop:
+		if len(template.Children) == 0 {
+			result = append(result, field.Invalid(path.Child("children"), template.Children, "children in template with type Loop could not be empty"))
+		}
+		for i, item := range template.Children {
+			result = append(result, templateMustExists(item, path.Child("children").Index(i), allTemplates)...)
+		}
+		result = append(result, validateLoop(path.Child("loop"), template.Loop)...)
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoFailurePolicy(path, template)...)
+	case templateType == TypeTemplateRef:
+		result = append(result, templateRefMustBeComplete(path.Child("templateRef"), template.TemplateRef)...)
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoChildren(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoStatusCheck(path, template)...)
+		result = append(result, shouldBeNoFailurePolicy(path, template)...)
 	default:
 		result = append(result, field.Invalid(path.Child("templateType"), template.Type, fmt.Sprintf("unrecognized template type: %s", template.Type)))
 	}
@@ -198,6 +273,39 @@ func templateMustExists(templateName string, path *field.Path, template []Templa
 	return result
 }
 
+// outputReferencesMustExist checks that the outputs referenced by the template are produced by a Task or
+// StatusCheck template in the same workflow
+func outputReferencesMustExist(path *field.Path, template Template, allTemplates []Template) field.ErrorList {
+	var result field.ErrorList
+
+	names, err := OutputReferences(template)
+	if err != nil {
+		return append(result, field.Invalid(path, template.Name, err.Error()))
+	}
+
+	for _, name := range names {
+		if name == template.Name {
+			result = append(result, field.Invalid(path, name, "template could not reference the outputs of itself"))
+			continue
+		}
+		var referenced *Template
+		for i := range allTemplates {
+			if allTemplates[i].Name == name {
+				referenced = &allTemplates[i]
+				break
+			}
+		}
+		if referenced == nil {
+			result = append(result, field.Invalid(path, name, fmt.Sprintf("can not find a template with name %s", name)))
+			continue
+		}
+		if referenced.Type != TypeTask && referenced.Type != TypeStatusCheck {
+			result = append(result, field.Invalid(path, name, fmt.Sprintf("template %s with type %s has no outputs", name, referenced.Type)))
+		}
+	}
+	return result
+}
+
 func shouldNotSetupDurationInTheChaos(path *field.Path, template Template) field.ErrorList {
 	var result field.ErrorList
 
@@ -265,6 +373,123 @@ func shouldBeNoEmbedChaos(path *field.Path, template Template) field.ErrorList {
 	return nil
 }
 
+func validateLoop(path *field.Path, loop *LoopSpec) field.ErrorList {
+	if loop == nil {
+		return field.ErrorList{
+			field.Required(path, "loop in template with type Loop is required"),
+		}
+	}
+
+	var result field.ErrorList
+	if loop.Count == nil && len(loop.Until) == 0 {
+		result = append(result, field.Invalid(path, loop, "either count or until is required, otherwise the loop never stops"))
+	}
+	if loop.Count != nil && *loop.Count < 1 {
+		result = append(result, field.Invalid(path.Child("count"), *loop.Count, "count should be greater than 0"))
+	}
+	if len(loop.Until) != 0 {
+		// the context of the last Task is only known at runtime, so only "iteration" is typed
+		_, err := expr.Compile(loop.Until,
+			expr.Env(map[string]interface{}{"iteration": 0}),
+			expr.AllowUndefinedVariables(),
+			expr.AsBool(),
+		)
+		if err != nil {
+			result = append(result, field.Invalid(path.Child("until"), loop.Until, fmt.Sprintf("compile until expression error: %s", err)))
+		}
+	}
+	if loop.Interval != nil {
+		if _, err := time.ParseDuration(*loop.Interval); err != nil {
+			result = append(result, field.Invalid(path.Child("interval"), *loop.Interval, fmt.Sprintf("parse interval field error: %s", err)))
+		}
+	}
+	return result
+}
+
+func validateFailurePolicy(path *field.Path, template Template, allTemplates []Template) field.ErrorList {
+	var result field.ErrorList
+	result = append(result, validateRetryStrategy(path, template)...)
+	result = append(result, validateHooks(path, template, allTemplates)...)
+	return result
+}
+
+func validateRetryStrategy(path *field.Path, template Template) field.ErrorList {
+	var result field.ErrorList
+	if template.RetryStrategy != nil {
+		retryStrategyPath := path.Child("retryStrategy")
+		if template.RetryStrategy.Limit < 0 {
+			result = append(result, field.Invalid(retryStrategyPath.Child("limit"), template.RetryStrategy.Limit, "limit should not be negative"))
+		}
+		if template.RetryStrategy.Backoff != nil {
+			if _, err := time.ParseDuration(*template.RetryStrategy.Backoff); err != nil {
+				result = append(result, field.Invalid(retryStrategyPath.Child("backoff"), *template.RetryStrategy.Backoff, fmt.Sprintf("parse backoff field error: %s", err)))
+			}
+		}
+	}
+	return result
+}
+
+func validateHooks(path *field.Path, template Template, allTemplates []Template) field.ErrorList {
+	var result field.ErrorList
+	if len(template.OnFailure) > 0 {
+		result = append(result, templateMustExists(template.OnFailure, path.Child("onFailure"), allTemplates)...)
+	}
+	if len(template.OnExit) > 0 {
+		result = append(result, templateMustExists(template.OnExit, path.Child("onExit"), allTemplates)...)
+	}
+	return result
+}
+
+func shouldBeNoFailurePolicy(path *field.Path, template Template) field.ErrorList {
+	var result field.ErrorList
+	result = append(result, shouldBeNoRetryStrategy(path, template)...)
+	if len(template.OnFailure) > 0 {
+		result = append(result, field.Invalid(path.Child("onFailure"), template.OnFailure, "this template should not contain OnFailure hook"))
+	}
+	if len(template.OnExit) > 0 {
+		result = append(result, field.Invalid(path.Child("onExit"), template.OnExit, "this template should not contain OnExit hook"))
+	}
+	return result
+}
+
+func shouldBeNoRetryStrategy(path *field.Path, template Template) field.ErrorList {
+	if template.RetryStrategy != nil {
+		return field.ErrorList{
+			field.Invalid(path, template.RetryStrategy, "this template should not contain RetryStrategy"),
+		}
+	}
+	return nil
+}
+
+func templateRefMustBeComplete(path *field.Path, templateRef *TemplateRef) field.ErrorList {
+	if templateRef == nil {
+		return field.ErrorList{
+			field.Required(path, "templateRef in template with type TemplateRef is required"),
+		}
+	}
+
+	var result field.ErrorList
+	if len(templateRef.Name) == 0 {
+		result = append(result, field.Required(path.Child("name"), "the name of WorkflowTemplate is required"))
+	}
+	if len(templateRef.Template) == 0 {
+		result = append(result, field.Required(path.Child("template"), "the name of template in WorkflowTemplate is required"))
+	}
+	if templateRef.ClusterScope && len(templateRef.Namespace) > 0 {
+		result = append(result, field.Invalid(path.Child("namespace"), templateRef.Namespace, "the namespace should be empty when referencing a ClusterWorkflowTemplate"))
+	}
+	return result
+}
+
+func shouldBeNoStatusCheck(path *field.Path, template Template) field.ErrorList {
+	if template.StatusCheck != nil {
+		return field.ErrorList{
+			field.Invalid(path, template.StatusCheck, "this template should not contain StatusCheck"),
+		}
+	}
+	return nil
+}
+
 func shouldBeNoSchedule(path *field.Path, template Template) field.ErrorList {
 	if template.Schedule != nil {
 		return field.ErrorList{
@@ -77,6 +77,7 @@ func Test_entryMustExists(t *testing.T) {
 
 func Test_validateTemplates(t *testing.T) {
 	templatesPath := field.NewPath("spec", "templates")
+	deadline := "10s"
 	var nilTemplates []Template
 	type args struct {
 		path      *field.Path
@@ -105,6 +106,30 @@ func Test_validateTemplates(t *testing.T) {
 			want: field.ErrorList{
 				field.Invalid(templatesPath, []Template{}, "templates in workflow could not be empty"),
 			},
+		}, {
+			name: "hooks of serial",
+			args: args{
+				path: templatesPath,
+				templates: []Template{
+					{Name: "branch", Type: TypeSerial, Children: []string{"sleep"}, OnFailure: "teardown", OnExit: "teardown"},
+					{Name: "sleep", Type: TypeSuspend, Deadline: &deadline},
+					{Name: "teardown", Type: TypeSuspend, Deadline: &deadline},
+				},
+			},
+			want: nil,
+		}, {
+			name: "retry strategy of parallel",
+			args: args{
+				path: templatesPath,
+				templates: []Template{
+					{Name: "branch", Type: TypeParallel, Children: []string{"sleep"}, RetryStrategy: &RetryStrategy{Limit: 1}, OnExit: "cleanup"},
+					{Name: "sleep", Type: TypeSuspend, Deadline: &deadline},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(templatesPath.Index(0), &RetryStrategy{Limit: 1}, "this template should not contain RetryStrategy"),
+				field.Invalid(templatesPath.Index(0).Child("onExit"), "cleanup", fmt.Sprintf("can not find a template with name %s", "cleanup")),
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -480,3 +505,454 @@ func Test_shouldNotSetupDurationInTheChaos(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateParameters(t *testing.T) {
+	parametersPath := field.NewPath("spec", "parameters")
+	tests := []struct {
+		name       string
+		parameters []Parameter
+		want       field.ErrorList
+	}{
+		{
+			name:       "valid parameters",
+			parameters: []Parameter{{Name: "latency"}, {Name: "namespace"}},
+			want:       nil,
+		}, {
+			name:       "name is required",
+			parameters: []Parameter{{Name: ""}},
+			want: field.ErrorList{
+				field.Required(parametersPath.Index(0).Child("name"), "name of parameter is required"),
+			},
+		}, {
+			name:       "names could not be duplicated",
+			parameters: []Parameter{{Name: "latency"}, {Name: "latency"}},
+			want: field.ErrorList{
+				field.Duplicate(parametersPath.Index(1).Child("name"), "latency"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateParameters(parametersPath, tt.parameters); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateParameters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_renderTemplates(t *testing.T) {
+	specPath := field.NewPath("spec")
+	defaultLatency := "10ms"
+	deadline := "{{workflow.parameters.deadline}}"
+	renderedDeadline := "5m"
+	template := Template{
+		Name:     "network-delay",
+		Type:     TypeNetworkChaos,
+		Deadline: &deadline,
+		EmbedChaos: &EmbedChaos{
+			NetworkChaos: &NetworkChaosSpec{
+				Action: DelayAction,
+				TcParameter: TcParameter{
+					Delay: &DelaySpec{Latency: "{{ workflow.parameters.latency }}"},
+				},
+			},
+		},
+	}
+	renderedTemplate := Template{
+		Name:     "network-delay",
+		Type:     TypeNetworkChaos,
+		Deadline: &renderedDeadline,
+		EmbedChaos: &EmbedChaos{
+			NetworkChaos: &NetworkChaosSpec{
+				Action: DelayAction,
+				TcParameter: TcParameter{
+					Delay: &DelaySpec{Latency: defaultLatency},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		spec      WorkflowSpec
+		templates []Template
+		errs      int
+	}{
+		{
+			name: "use arguments and default values",
+			spec: WorkflowSpec{
+				Templates:  []Template{template},
+				Parameters: []Parameter{{Name: "latency", Default: &defaultLatency}, {Name: "deadline"}},
+				Arguments:  []Argument{{Name: "deadline", Value: "5m"}},
+			},
+			templates: []Template{renderedTemplate},
+			errs:      0,
+		}, {
+			name: "missing required parameter",
+			spec: WorkflowSpec{
+				Templates:  []Template{template},
+				Parameters: []Parameter{{Name: "latency", Default: &defaultLatency}, {Name: "deadline"}},
+			},
+			templates: []Template{template},
+			errs:      1,
+		}, {
+			name: "argument is not declared",
+			spec: WorkflowSpec{
+				Templates:  []Template{template},
+				Parameters: []Parameter{{Name: "latency", Default: &defaultLatency}, {Name: "deadline"}},
+				Arguments:  []Argument{{Name: "deadline", Value: "5m"}, {Name: "namespace", Value: "tidb-cluster"}},
+			},
+			templates: []Template{template},
+			errs:      1,
+		}, {
+			name: "reference is not declared",
+			spec: WorkflowSpec{
+				Templates:  []Template{template},
+				Parameters: []Parameter{{Name: "latency", Default: &defaultLatency}},
+			},
+			templates: []Template{template},
+			errs:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates, errs := renderTemplates(specPath, tt.spec)
+			if !reflect.DeepEqual(templates, tt.templates) {
+				t.Errorf("renderTemplates() templates = %v, want %v", templates, tt.templates)
+			}
+			if len(errs) != tt.errs {
+				t.Errorf("renderTemplates() errs = %v, want %d errors", errs, tt.errs)
+			}
+		})
+	}
+}
+
+func Test_templateRefMustBeComplete(t *testing.T) {
+	templateRefPath := field.NewPath("spec", "templates").Index(0).Child("templateRef")
+	type args struct {
+		path        *field.Path
+		templateRef *TemplateRef
+	}
+	tests := []struct {
+		name string
+		args args
+		want field.ErrorList
+	}{
+		{
+			name: "templateRef is nil",
+			args: args{
+				path:        templateRefPath,
+				templateRef: nil,
+			},
+			want: field.ErrorList{
+				field.Required(templateRefPath, "templateRef in template with type TemplateRef is required"),
+			},
+		}, {
+			name: "templateRef without template",
+			args: args{
+				path: templateRefPath,
+				templateRef: &TemplateRef{
+					Name: "library",
+				},
+			},
+			want: field.ErrorList{
+				field.Required(templateRefPath.Child("template"), "the name of template in WorkflowTemplate is required"),
+			},
+		}, {
+			name: "complete templateRef",
+			args: args{
+				path: templateRefPath,
+				templateRef: &TemplateRef{
+					Name:      "library",
+					Namespace: "chaos-mesh",
+					Template:  "network-delay",
+				},
+			},
+			want: nil,
+		}, {
+			name: "cluster scoped templateRef with namespace",
+			args: args{
+				path: templateRefPath,
+				templateRef: &TemplateRef{
+					Name:         "library",
+					Namespace:    "chaos-mesh",
+					Template:     "network-delay",
+					ClusterScope: true,
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(templateRefPath.Child("namespace"), "chaos-mesh", "the namespace should be empty when referencing a ClusterWorkflowTemplate"),
+			},
+		}, {
+			name: "complete cluster scoped templateRef",
+			args: args{
+				path: templateRefPath,
+				templateRef: &TemplateRef{
+					Name:         "library",
+					Template:     "network-delay",
+					ClusterScope: true,
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := templateRefMustBeComplete(tt.args.path, tt.args.templateRef); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("templateRefMustBeComplete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateLoop(t *testing.T) {
+	loopPath := field.NewPath("spec", "templates").Index(0).Child("loop")
+	zero := 0
+	three := 3
+	invalidInterval := "1"
+	emptyLoop := &LoopSpec{}
+	type args struct {
+		path *field.Path
+		loop *LoopSpec
+	}
+	tests := []struct {
+		name string
+		args args
+		want field.ErrorList
+	}{
+		{
+			name: "loop is nil",
+			args: args{
+				path: loopPath,
+				loop: nil,
+			},
+			want: field.ErrorList{
+				field.Required(loopPath, "loop in template with type Loop is required"),
+			},
+		}, {
+			name: "neither count nor until",
+			args: args{
+				path: loopPath,
+				loop: emptyLoop,
+			},
+			want: field.ErrorList{
+				field.Invalid(loopPath, emptyLoop, "either count or until is required, otherwise the loop never stops"),
+			},
+		}, {
+			name: "invalid count",
+			args: args{
+				path: loopPath,
+				loop: &LoopSpec{Count: &zero},
+			},
+			want: field.ErrorList{
+				field.Invalid(loopPath.Child("count"), 0, "count should be greater than 0"),
+			},
+		}, {
+			name: "invalid interval",
+			args: args{
+				path: loopPath,
+				loop: &LoopSpec{Count: &three, Interval: &invalidInterval},
+			},
+			want: field.ErrorList{
+				field.Invalid(loopPath.Child("interval"), "1", `parse interval field error: time: missing unit in duration "1"`),
+			},
+		}, {
+			name: "until is not boolean",
+			args: args{
+				path: loopPath,
+				loop: &LoopSpec{Until: "iteration + 1"},
+			},
+			want: field.ErrorList{
+				field.Invalid(loopPath.Child("until"), "iteration + 1", "compile until expression error: expected bool, but got int"),
+			},
+		}, {
+			name: "valid loop",
+			args: args{
+				path: loopPath,
+				loop: &LoopSpec{Until: "exitCode == 0 && iteration >= 2"},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateLoop(tt.args.path, tt.args.loop); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateLoop() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateFailurePolicy(t *testing.T) {
+	templatePath := field.NewPath("spec", "templates").Index(0)
+	invalidBackoff := "10"
+	allTemplates := []Template{
+		{
+			Name: "teardown",
+			Type: TypeTask,
+		},
+	}
+	type args struct {
+		path     *field.Path
+		template Template
+	}
+	tests := []struct {
+		name string
+		args args
+		want field.ErrorList
+	}{
+		{
+			name: "valid failure policy",
+			args: args{
+				path: templatePath,
+				template: Template{
+					RetryStrategy: &RetryStrategy{Limit: 3},
+					OnFailure:     "teardown",
+					OnExit:        "teardown",
+				},
+			},
+			want: nil,
+		}, {
+			name: "invalid retry strategy",
+			args: args{
+				path: templatePath,
+				template: Template{
+					RetryStrategy: &RetryStrategy{Limit: -1, Backoff: &invalidBackoff},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(templatePath.Child("retryStrategy").Child("limit"), -1, "limit should not be negative"),
+				field.Invalid(templatePath.Child("retryStrategy").Child("backoff"), "10", `parse backoff field error: time: missing unit in duration "10"`),
+			},
+		}, {
+			name: "hook does not exist",
+			args: args{
+				path: templatePath,
+				template: Template{
+					OnExit: "cleanup",
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(templatePath.Child("onExit"), "cleanup", fmt.Sprintf("can not find a template with name %s", "cleanup")),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateFailurePolicy(tt.args.path, tt.args.template, allTemplates); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateFailurePolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_shouldBeNoFailurePolicy(t *testing.T) {
+	templatePath := field.NewPath("spec", "templates").Index(0)
+	retryStrategy := &RetryStrategy{Limit: 1}
+	type args struct {
+		path     *field.Path
+		template Template
+	}
+	tests := []struct {
+		name string
+		args args
+		want field.ErrorList
+	}{
+		{
+			name: "unexpected failure policy",
+			args: args{
+				path: templatePath,
+				template: Template{
+					RetryStrategy: retryStrategy,
+					OnExit:        "teardown",
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(templatePath, retryStrategy, "this template should not contain RetryStrategy"),
+				field.Invalid(templatePath.Child("onExit"), "teardown", "this template should not contain OnExit hook"),
+			},
+		}, {
+			name: "no failure policy",
+			args: args{
+				path:     templatePath,
+				template: Template{},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldBeNoFailurePolicy(tt.args.path, tt.args.template); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shouldBeNoFailurePolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_outputReferencesMustExist(t *testing.T) {
+	templatePath := field.NewPath("spec", "templates").Index(0)
+	leaderReference := "{{ nodes.find-leader.outputs.stdout }}"
+	missingReference := "{{nodes.discover.outputs.stdout}}"
+	suspendReference := "{{nodes.sleep.outputs.stdout}}"
+	allTemplates := []Template{
+		{
+			Name: "find-leader",
+			Type: TypeTask,
+		}, {
+			Name: "sleep",
+			Type: TypeSuspend,
+		},
+	}
+	tests := []struct {
+		name     string
+		template Template
+		want     field.ErrorList
+	}{
+		{
+			name: "reference the outputs of a task",
+			template: Template{
+				Name:     "partition-leader",
+				Type:     TypeSuspend,
+				Deadline: &leaderReference,
+			},
+			want: nil,
+		}, {
+			name: "referenced template does not exist",
+			template: Template{
+				Name:     "partition-leader",
+				Type:     TypeSuspend,
+				Deadline: &missingReference,
+			},
+			want: field.ErrorList{
+				field.Invalid(templatePath, "discover", "can not find a template with name discover"),
+			},
+		}, {
+			name: "referenced template has no outputs",
+			template: Template{
+				Name:     "partition-leader",
+				Type:     TypeSuspend,
+				Deadline: &suspendReference,
+			},
+			want: field.ErrorList{
+				field.Invalid(templatePath, "sleep", "template sleep with type Suspend has no outputs"),
+			},
+		}, {
+			name: "reference the outputs of itself",
+			template: Template{
+				Name:     "find-leader",
+				Type:     TypeTask,
+				Deadline: &leaderReference,
+			},
+			want: field.ErrorList{
+				field.Invalid(templatePath, "find-leader", "template could not reference the outputs of itself"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outputReferencesMustExist(templatePath, tt.template, allTemplates); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("outputReferencesMustExist() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 const (
 	LabelControlledBy       = "chaos-mesh.org/controlled-by"
 	LabelWorkflow           = "chaos-mesh.org/workflow"
+	LabelHook               = "chaos-mesh.org/workflow-hook"
 	WorkflowAnnotationAbort = "workflow.chaos-mesh.org/abort"
 )
 
@@ -55,6 +56,9 @@ type WorkflowNodeSpec struct {
 	Task *Task `json:"task,omitempty"`
 	// +optional
 	Children []string `json:"children,omitempty"`
+	// Loop describes the repetition of children. Only used when Type is TypeLoop.
+	// +optional
+	Loop *LoopSpec `json:"loop,omitempty"`
 	// +optional
 	ConditionalBranches []ConditionalBranch `json:"conditionalBranches,omitempty"`
 	// +optional
@@ -68,6 +72,15 @@ type WorkflowNodeSpec struct {
 	// Only used when Type is TypeStatusCheck.
 	// +optional
 	AbortWithStatusCheck bool `json:"abortWithStatusCheck,omitempty"`
+	// RetryStrategy describes how to retry the node when it fails.
+	// +optional
+	RetryStrategy *RetryStrategy `json:"retryStrategy,omitempty"`
+	// OnFailure is the name of the template to spawn once the node fails and there are no more retries.
+	// +optional
+	OnFailure string `json:"onFailure,omitempty"`
+	// OnExit is the name of the template to spawn once the node finishes.
+	// +optional
+	OnExit string `json:"onExit,omitempty"`
 }
 
 type WorkflowNodeStatus struct {
@@ -88,6 +101,19 @@ type WorkflowNodeStatus struct {
 	// +optional
 	FinishedChildren []corev1.LocalObjectReference `json:"finishedChildren,omitempty"`
 
+	// LoopStatus records the progress of the loop node. Only used when Type is TypeLoop.
+	// +optional
+	LoopStatus *LoopStatus `json:"loopStatus,omitempty"`
+
+	// RetryStatus records the retries of the failed node.
+	// +optional
+	RetryStatus *RetryStatus `json:"retryStatus,omitempty"`
+
+	// Outputs records the outputs of the finished Task or StatusCheck node, they could be referenced
+	// by the later templates like "{{nodes.<template name>.outputs.<key>}}".
+	// +optional
+	Outputs map[string]string `json:"outputs,omitempty"`
+
 	// Represents the latest available observations of a workflow node's current state.
 	// +optional
 	// +patchMergeKey=type
@@ -115,6 +141,22 @@ type ConditionalBranchStatus struct {
 	EvaluationResult corev1.ConditionStatus `json:"evaluationResult"`
 }
 
+type LoopStatus struct {
+	// FinishedIterations is the number of the finished iterations
+	FinishedIterations int `json:"finishedIterations"`
+	// LastIterationFinishedTime is the time when the last iteration is observed as finished
+	// +optional
+	LastIterationFinishedTime *metav1.Time `json:"lastIterationFinishedTime,omitempty"`
+}
+
+type RetryStatus struct {
+	// Retries is the number of the retries
+	Retries int `json:"retries"`
+	// LastFailureTime is the time of the last failure
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+}
+
 type WorkflowNodeConditionType string
 
 const (
@@ -122,6 +164,7 @@ const (
 	ConditionDeadlineExceed WorkflowNodeConditionType = "DeadlineExceed"
 	ConditionChaosInjected  WorkflowNodeConditionType = "ChaosInjected"
 	ConditionAborted        WorkflowNodeConditionType = "Aborted"
+	ConditionFailed         WorkflowNodeConditionType = "Failed"
 )
 
 type WorkflowNodeCondition struct {
@@ -145,6 +188,7 @@ func init() {
 const (
 	EntryCreated                         string = "EntryCreated"
 	InvalidEntry                         string = "InvalidEntry"
+	InvalidTemplateRef                   string = "InvalidTemplateRef"
 	WorkflowAccomplished                 string = "WorkflowAccomplished"
 	NodeAccomplished                     string = "NodeAccomplished"
 	NodesCreated                         string = "NodesCreated"
@@ -170,6 +214,12 @@ const (
 	StatusCheckNotExceedSuccessThreshold string = "StatusCheckNotExceedSuccessThreshold"
 	ParentNodeAborted                    string = "ParentNodeAborted"
 	WorkflowAborted                      string = "WorkflowAborted"
+	TaskPodFailed                        string = "TaskPodFailed"
+	ChaosInjectFailed                    string = "ChaosInjectFailed"
+	ChildNodeFailed                      string = "ChildNodeFailed"
+	RetryScheduled                       string = "RetryScheduled"
+	NodeFailed                           string = "NodeFailed"
+	LoopUntilEvaluationFailed            string = "LoopUntilEvaluationFailed"
 )
 
 // GenericChaosList only use to list GenericChaos by certain EmbedChaos
@@ -0,0 +1,79 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const KindWorkflowTemplate = "WorkflowTemplate"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=wft
+
+// WorkflowTemplate is a library of templates, which could be referenced by the templates in
+// Workflows with type TemplateRef.
+type WorkflowTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the templates in the library
+	Spec WorkflowTemplateSpec `json:"spec"`
+}
+
+type WorkflowTemplateSpec struct {
+	// +optional
+	Description string     `json:"description,omitempty"`
+	Templates   []Template `json:"templates"`
+}
+
+// TemplateRef references a template in a WorkflowTemplate or a ClusterWorkflowTemplate.
+type TemplateRef struct {
+	// Name is the name of the WorkflowTemplate, or the ClusterWorkflowTemplate if ClusterScope is true
+	Name string `json:"name"`
+	// Namespace is the namespace of the WorkflowTemplate, default is the namespace of the Workflow.
+	// It should be empty if ClusterScope is true.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// ClusterScope indicates the template is referenced from a ClusterWorkflowTemplate.
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty"`
+	// Template is the name of the template in the WorkflowTemplate
+	Template string `json:"template"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowTemplateList contains a list of WorkflowTemplate
+type WorkflowTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowTemplate `json:"items"`
+}
+
+// FindTemplate returns the template with the given name in the library
+func (in *WorkflowTemplateSpec) FindTemplate(name string) (Template, bool) {
+	for _, template := range in.Templates {
+		if template.Name == name {
+			return template, true
+		}
+	}
+	return Template{}, false
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkflowTemplate{}, &WorkflowTemplateList{})
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	gw "github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
+)
+
+var _ webhook.Validator = &WorkflowTemplate{}
+
+func (in *WorkflowTemplate) ValidateCreate() error {
+	allErrs := in.Spec.validate(field.NewPath("spec"))
+	if len(allErrs) > 0 {
+		return errors.New(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
+
+func (in *WorkflowTemplate) ValidateUpdate(old runtime.Object) error {
+	return in.ValidateCreate()
+}
+
+func (in *WorkflowTemplate) ValidateDelete() error {
+	return nil
+}
+
+var _ webhook.Defaulter = &WorkflowTemplate{}
+
+func (in *WorkflowTemplate) Default() {
+	gw.Default(in)
+}
+
+func (in *WorkflowTemplateSpec) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	templatesPath := path.Child("templates")
+	allErrs = append(allErrs, validateTemplates(templatesPath, in.Templates)...)
+	for i, template := range in.Templates {
+		if template.Type == TypeTemplateRef {
+			allErrs = append(allErrs, field.Invalid(templatesPath.Index(i).Child("templateType"), template.Type,
+				"template in WorkflowTemplate could not reference other templates"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("workflowtemplate_webhook", func() {
+	Context("webhook.Validator of workflowtemplate", func() {
+		It("Validate", func() {
+			type TestCase struct {
+				name             string
+				workflowTemplate WorkflowTemplate
+				expect           string
+			}
+			tcs := []TestCase{
+				{
+					name: "simple Validate",
+					workflowTemplate: WorkflowTemplate{
+						Spec: WorkflowTemplateSpec{
+							Templates: []Template{
+								{
+									Name:     "suspend",
+									Type:     TypeSuspend,
+									Deadline: &[]string{"1m"}[0],
+								},
+							},
+						},
+					},
+					expect: "",
+				},
+				{
+					name: "empty templates",
+					workflowTemplate: WorkflowTemplate{
+						Spec: WorkflowTemplateSpec{},
+					},
+					expect: "could not be empty",
+				},
+				{
+					name: "nested templateRef",
+					workflowTemplate: WorkflowTemplate{
+						Spec: WorkflowTemplateSpec{
+							Templates: []Template{
+								{
+									Name: "ref",
+									Type: TypeTemplateRef,
+									TemplateRef: &TemplateRef{
+										Name:     "library",
+										Template: "suspend",
+									},
+								},
+							},
+						},
+					},
+					expect: "could not reference other templates",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.workflowTemplate.ValidateCreate()
+				if len(tc.expect) != 0 {
+					Expect(err).To(HaveOccurred())
+					Expect(strings.Contains(err.Error(), tc.expect)).To(BeTrue())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				clusterWorkflowTemplate := ClusterWorkflowTemplate{Spec: tc.workflowTemplate.Spec}
+				err = clusterWorkflowTemplate.ValidateCreate()
+				if len(tc.expect) != 0 {
+					Expect(err).To(HaveOccurred())
+					Expect(strings.Contains(err.Error(), tc.expect)).To(BeTrue())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	})
+})
@@ -737,6 +737,144 @@ func (in *GCPChaos) Default() {
 	gw.Default(in)
 }
 
+const KindGRPCChaos = "GRPCChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *GRPCChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *GRPCChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetObjectMeta would return the ObjectMeta for chaos
+func (in *GRPCChaos) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetDuration would return the duration for chaos
+func (in *GRPCChaosSpec) GetDuration() (*time.Duration, error) {
+	if in.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(string(*in.Duration))
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// GetStatus returns the status
+func (in *GRPCChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetRemoteCluster returns the remoteCluster
+func (in *GRPCChaos) GetRemoteCluster() string {
+	return in.Spec.RemoteCluster
+}
+
+// GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
+func (in *GRPCChaos) GetSpecAndMetaString() (string, error) {
+	spec, err := json.Marshal(in.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	meta := in.ObjectMeta.DeepCopy()
+	meta.SetResourceVersion("")
+	meta.SetGeneration(0)
+
+	return string(spec) + meta.String(), nil
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCChaosList contains a list of GRPCChaos
+type GRPCChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCChaos `json:"items"`
+}
+
+func (in *GRPCChaosList) DeepCopyList() GenericChaosList {
+	return in.DeepCopy()
+}
+
+// ListChaos returns a list of chaos
+func (in *GRPCChaosList) ListChaos() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
+
+func (in *GRPCChaos) DurationExceeded(now time.Time) (bool, time.Duration, error) {
+	duration, err := in.Spec.GetDuration()
+	if err != nil {
+		return false, 0, err
+	}
+
+	if duration != nil {
+		stopTime := in.GetCreationTimestamp().Add(*duration)
+		if stopTime.Before(now) {
+			return true, 0, nil
+		}
+
+		return false, stopTime.Sub(now), nil
+	}
+
+	return false, 0, nil
+}
+
+func (in *GRPCChaos) IsOneShot() bool {
+	return false
+}
+
+var GRPCChaosWebhookLog = logf.Log.WithName("GRPCChaos-resource")
+
+func (in *GRPCChaos) ValidateCreate() error {
+	GRPCChaosWebhookLog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *GRPCChaos) ValidateUpdate(old runtime.Object) error {
+	GRPCChaosWebhookLog.Info("validate update", "name", in.Name)
+	if !reflect.DeepEqual(in.Spec, old.(*GRPCChaos).Spec) {
+		return ErrCanNotUpdateChaos
+	}
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *GRPCChaos) ValidateDelete() error {
+	GRPCChaosWebhookLog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+var _ webhook.Validator = &GRPCChaos{}
+
+func (in *GRPCChaos) Validate() error {
+	errs := gw.Validate(in)
+	return gw.Aggregate(errs)
+}
+
+var _ webhook.Defaulter = &GRPCChaos{}
+
+func (in *GRPCChaos) Default() {
+	gw.Default(in)
+}
+
 const KindHTTPChaos = "HTTPChaos"
 
 // IsDeleted returns whether this resource has been deleted
@@ -1702,7 +1840,7 @@ func (in *PodChaos) DurationExceeded(now time.Time) (bool, time.Duration, error)
 }
 
 func (in *PodChaos) IsOneShot() bool {
-	if in.Spec.Action==PodKillAction || in.Spec.Action==ContainerKillAction {
+	if in.Spec.Action==PodKillAction || in.Spec.Action==ContainerKillAction || in.Spec.Action==ProcessKillAction {
 		return true
 	}
 
@@ -1746,6 +1884,42 @@ func (in *PodChaos) Default() {
 	gw.Default(in)
 }
 
+const KindPodGrpcChaos = "PodGrpcChaos"
+
+var PodGrpcChaosWebhookLog = logf.Log.WithName("PodGrpcChaos-resource")
+
+func (in *PodGrpcChaos) ValidateCreate() error {
+	PodGrpcChaosWebhookLog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *PodGrpcChaos) ValidateUpdate(old runtime.Object) error {
+	PodGrpcChaosWebhookLog.Info("validate update", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *PodGrpcChaos) ValidateDelete() error {
+	PodGrpcChaosWebhookLog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil
+}
+
+var _ webhook.Validator = &PodGrpcChaos{}
+
+func (in *PodGrpcChaos) Validate() error {
+	errs := gw.Validate(in)
+	return gw.Aggregate(errs)
+}
+
+var _ webhook.Defaulter = &PodGrpcChaos{}
+
+func (in *PodGrpcChaos) Default() {
+	gw.Default(in)
+}
+
 const KindPodHttpChaos = "PodHttpChaos"
 
 var PodHttpChaosWebhookLog = logf.Log.WithName("PodHttpChaos-resource")
@@ -2240,6 +2414,12 @@ func init() {
 		list:  &GCPChaosList{},
 	})
 
+	SchemeBuilder.Register(&GRPCChaos{}, &GRPCChaosList{})
+	all.register(KindGRPCChaos, &ChaosKind{
+		chaos: &GRPCChaos{},
+		list:  &GRPCChaosList{},
+	})
+
 	SchemeBuilder.Register(&HTTPChaos{}, &HTTPChaosList{})
 	all.register(KindHTTPChaos, &ChaosKind{
 		chaos: &HTTPChaos{},
@@ -2284,6 +2464,8 @@ func init() {
 		list:  &PodChaosList{},
 	})
 
+	SchemeBuilder.Register(&PodGrpcChaos{}, &PodGrpcChaosList{})
+
 	SchemeBuilder.Register(&PodHttpChaos{}, &PodHttpChaosList{})
 
 	SchemeBuilder.Register(&PodIOChaos{}, &PodIOChaosList{})
@@ -2332,6 +2514,11 @@ func init() {
 		list:  &GCPChaosList{},
 	})
 
+	allScheduleItem.register(KindGRPCChaos, &ChaosKind{
+		chaos: &GRPCChaos{},
+		list:  &GRPCChaosList{},
+	})
+
 	allScheduleItem.register(KindHTTPChaos, &ChaosKind{
 		chaos: &HTTPChaos{},
 		list:  &HTTPChaosList{},
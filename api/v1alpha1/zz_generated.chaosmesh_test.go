@@ -340,6 +340,69 @@ func TestGCPChaosListChaos(t *testing.T) {
 	chaos.ListChaos()
 }
 
+func TestGRPCChaosIsDeleted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &GRPCChaos{}
+	err := faker.FakeData(chaos)
+
+	g.Expect(err).To(BeNil())
+
+	chaos.IsDeleted()
+}
+
+func TestGRPCChaosIsIsPaused(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &GRPCChaos{}
+	err := faker.FakeData(chaos)
+
+	g.Expect(err).To(BeNil())
+
+	chaos.IsPaused()
+}
+
+func TestGRPCChaosGetDuration(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &GRPCChaos{}
+	err := faker.FakeData(chaos)
+
+	g.Expect(err).To(BeNil())
+
+	chaos.Spec.GetDuration()
+}
+
+func TestGRPCChaosGetStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &GRPCChaos{}
+	err := faker.FakeData(chaos)
+
+	g.Expect(err).To(BeNil())
+
+	chaos.GetStatus()
+}
+
+func TestGRPCChaosGetSpecAndMetaString(t *testing.T) {
+	g := NewGomegaWithT(t)
+	chaos := &GRPCChaos{}
+	err := faker.FakeData(chaos)
+	g.Expect(err).To(BeNil())
+	chaos.GetSpecAndMetaString()
+}
+
+func TestGRPCChaosListChaos(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &GRPCChaosList{}
+	err := faker.FakeData(chaos)
+
+	g.Expect(err).To(BeNil())
+
+	chaos.ListChaos()
+}
+
 func TestHTTPChaosIsDeleted(t *testing.T) {
 	g := NewGomegaWithT(t)
 
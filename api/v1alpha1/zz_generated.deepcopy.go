@@ -158,6 +158,21 @@ func (in *AWSSelector) DeepCopy() *AWSSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Argument) DeepCopyInto(out *Argument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Argument.
+func (in *Argument) DeepCopy() *Argument {
+	if in == nil {
+		return nil
+	}
+	out := new(Argument)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttrOverrideSpec) DeepCopyInto(out *AttrOverrideSpec) {
 	*out = *in
@@ -516,6 +531,11 @@ func (in *CPUStressor) DeepCopyInto(out *CPUStressor) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.QuotaPercent != nil {
+		in, out := &in.QuotaPercent, &out.QuotaPercent
+		*out = new(int)
+		**out = **in
+	}
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
 		*out = make([]string, len(*in))
@@ -639,6 +659,64 @@ func (in *ClockSpec) DeepCopy() *ClockSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkflowTemplate) DeepCopyInto(out *ClusterWorkflowTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowTemplate.
+func (in *ClusterWorkflowTemplate) DeepCopy() *ClusterWorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkflowTemplateList) DeepCopyInto(out *ClusterWorkflowTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterWorkflowTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowTemplateList.
+func (in *ClusterWorkflowTemplateList) DeepCopy() *ClusterWorkflowTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkflowTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionalBranch) DeepCopyInto(out *ConditionalBranch) {
 	*out = *in
@@ -855,6 +933,11 @@ func (in *DelaySpec) DeepCopyInto(out *DelaySpec) {
 		*out = new(ReorderSpec)
 		**out = **in
 	}
+	if in.DistributionTable != nil {
+		in, out := &in.DistributionTable, &out.DistributionTable
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DelaySpec.
@@ -957,6 +1040,11 @@ func (in *EmbedChaos) DeepCopyInto(out *EmbedChaos) {
 		*out = new(GCPChaosSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GRPCChaos != nil {
+		in, out := &in.GRPCChaos, &out.GRPCChaos
+		*out = new(GRPCChaosSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HTTPChaos != nil {
 		in, out := &in.HTTPChaos, &out.HTTPChaos
 		*out = new(HTTPChaosSpec)
@@ -1585,6 +1673,132 @@ func (in *GCPSelector) DeepCopy() *GCPSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaos) DeepCopyInto(out *GRPCChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaos.
+func (in *GRPCChaos) DeepCopy() *GRPCChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaosList) DeepCopyInto(out *GRPCChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GRPCChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaosList.
+func (in *GRPCChaosList) DeepCopy() *GRPCChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaosSpec) DeepCopyInto(out *GRPCChaosSpec) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	in.PodGrpcChaosActions.DeepCopyInto(&out.PodGrpcChaosActions)
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(PodHttpChaosTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaosSpec.
+func (in *GRPCChaosSpec) DeepCopy() *GRPCChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaosStatus) DeepCopyInto(out *GRPCChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaosStatus.
+func (in *GRPCChaosStatus) DeepCopy() *GRPCChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericSelectorSpec) DeepCopyInto(out *GenericSelectorSpec) {
 	*out = *in
@@ -1633,6 +1847,27 @@ func (in *GenericSelectorSpec) DeepCopy() *GenericSelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HDDStressor) DeepCopyInto(out *HDDStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HDDStressor.
+func (in *HDDStressor) DeepCopy() *HDDStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(HDDStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPAbortSpec) DeepCopyInto(out *HTTPAbortSpec) {
 	*out = *in
@@ -2048,6 +2283,27 @@ func (in *IOChaosStatus) DeepCopy() *IOChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOStressor) DeepCopyInto(out *IOStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOStressor.
+func (in *IOStressor) DeepCopy() *IOStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(IOStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IoFault) DeepCopyInto(out *IoFault) {
 	*out = *in
@@ -2497,6 +2753,21 @@ func (in *KernelChaosStatus) DeepCopy() *KernelChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KilledProcess) DeepCopyInto(out *KilledProcess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KilledProcess.
+func (in *KilledProcess) DeepCopy() *KilledProcess {
+	if in == nil {
+		return nil
+	}
+	out := new(KilledProcess)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in LabelSelectorRequirements) DeepCopyInto(out *LabelSelectorRequirements) {
 	{
@@ -2519,77 +2790,171 @@ func (in LabelSelectorRequirements) DeepCopy() LabelSelectorRequirements {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LossSpec) DeepCopyInto(out *LossSpec) {
+func (in *LoopSpec) DeepCopyInto(out *LoopSpec) {
 	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LossSpec.
-func (in *LossSpec) DeepCopy() *LossSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoopSpec.
+func (in *LoopSpec) DeepCopy() *LoopSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LossSpec)
+	out := new(LoopSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemoryStressor) DeepCopyInto(out *MemoryStressor) {
+func (in *LoopStatus) DeepCopyInto(out *LoopStatus) {
 	*out = *in
-	out.Stressor = in.Stressor
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LastIterationFinishedTime != nil {
+		in, out := &in.LastIterationFinishedTime, &out.LastIterationFinishedTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStressor.
-func (in *MemoryStressor) DeepCopy() *MemoryStressor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoopStatus.
+func (in *LoopStatus) DeepCopy() *LoopStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryStressor)
+	out := new(LoopStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MistakeSpec) DeepCopyInto(out *MistakeSpec) {
+func (in *LossGEModelSpec) DeepCopyInto(out *LossGEModelSpec) {
 	*out = *in
+	if in.R != nil {
+		in, out := &in.R, &out.R
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MistakeSpec.
-func (in *MistakeSpec) DeepCopy() *MistakeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LossGEModelSpec.
+func (in *LossGEModelSpec) DeepCopy() *LossGEModelSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MistakeSpec)
+	out := new(LossGEModelSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkBandwidthSpec) DeepCopyInto(out *NetworkBandwidthSpec) {
+func (in *LossSpec) DeepCopyInto(out *LossSpec) {
 	*out = *in
-	if in.Peakrate != nil {
-		in, out := &in.Peakrate, &out.Peakrate
-		*out = new(uint64)
-		**out = **in
-	}
-	if in.Minburst != nil {
-		in, out := &in.Minburst, &out.Minburst
-		*out = new(uint32)
-		**out = **in
+	if in.GEModel != nil {
+		in, out := &in.GEModel, &out.GEModel
+		*out = new(LossGEModelSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBandwidthSpec.
-func (in *NetworkBandwidthSpec) DeepCopy() *NetworkBandwidthSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LossSpec.
+func (in *LossSpec) DeepCopy() *LossSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkBandwidthSpec)
+	out := new(LossSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryRamp) DeepCopyInto(out *MemoryRamp) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryRamp.
+func (in *MemoryRamp) DeepCopy() *MemoryRamp {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryRamp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryStressor) DeepCopyInto(out *MemoryStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.LimitPercent != nil {
+		in, out := &in.LimitPercent, &out.LimitPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.Ramp != nil {
+		in, out := &in.Ramp, &out.Ramp
+		*out = new(MemoryRamp)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStressor.
+func (in *MemoryStressor) DeepCopy() *MemoryStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MistakeSpec) DeepCopyInto(out *MistakeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MistakeSpec.
+func (in *MistakeSpec) DeepCopy() *MistakeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MistakeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkBandwidthSpec) DeepCopyInto(out *NetworkBandwidthSpec) {
+	*out = *in
+	if in.Peakrate != nil {
+		in, out := &in.Peakrate, &out.Peakrate
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Minburst != nil {
+		in, out := &in.Minburst, &out.Minburst
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBandwidthSpec.
+func (in *NetworkBandwidthSpec) DeepCopy() *NetworkBandwidthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBandwidthSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2673,6 +3038,23 @@ func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TargetServices != nil {
+		in, out := &in.TargetServices, &out.TargetServices
+		*out = make([]ServiceTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]uint16, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourcePorts != nil {
+		in, out := &in.SourcePorts, &out.SourcePorts
+		*out = make([]uint16, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosSpec.
@@ -2864,6 +3246,26 @@ func (in *PMJVMMySQLSpec) DeepCopy() *PMJVMMySQLSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Parameter) DeepCopyInto(out *Parameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
+func (in *Parameter) DeepCopy() *Parameter {
+	if in == nil {
+		return nil
+	}
+	out := new(Parameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PhysicalMachine) DeepCopyInto(out *PhysicalMachine) {
 	*out = *in
@@ -3113,6 +3515,26 @@ func (in *PodChaos) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodChaosInstance) DeepCopyInto(out *PodChaosInstance) {
+	*out = *in
+	if in.KilledProcesses != nil {
+		in, out := &in.KilledProcesses, &out.KilledProcesses
+		*out = make([]KilledProcess, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosInstance.
+func (in *PodChaosInstance) DeepCopy() *PodChaosInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(PodChaosInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodChaosList) DeepCopyInto(out *PodChaosList) {
 	*out = *in
@@ -3170,6 +3592,13 @@ func (in *PodChaosSpec) DeepCopy() *PodChaosSpec {
 func (in *PodChaosStatus) DeepCopyInto(out *PodChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]PodChaosInstance, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosStatus.
@@ -3182,6 +3611,249 @@ func (in *PodChaosStatus) DeepCopy() *PodChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaos) DeepCopyInto(out *PodGrpcChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaos.
+func (in *PodGrpcChaos) DeepCopy() *PodGrpcChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodGrpcChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosAbortAction) DeepCopyInto(out *PodGrpcChaosAbortAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosAbortAction.
+func (in *PodGrpcChaosAbortAction) DeepCopy() *PodGrpcChaosAbortAction {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosAbortAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosActions) DeepCopyInto(out *PodGrpcChaosActions) {
+	*out = *in
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(PodGrpcChaosAbortAction)
+		**out = **in
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replace != nil {
+		in, out := &in.Replace, &out.Replace
+		*out = new(PodGrpcChaosReplaceActions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosActions.
+func (in *PodGrpcChaosActions) DeepCopy() *PodGrpcChaosActions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosActions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosBaseRule) DeepCopyInto(out *PodGrpcChaosBaseRule) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.Actions.DeepCopyInto(&out.Actions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosBaseRule.
+func (in *PodGrpcChaosBaseRule) DeepCopy() *PodGrpcChaosBaseRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosBaseRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosList) DeepCopyInto(out *PodGrpcChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodGrpcChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosList.
+func (in *PodGrpcChaosList) DeepCopy() *PodGrpcChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodGrpcChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosReplaceActions) DeepCopyInto(out *PodGrpcChaosReplaceActions) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosReplaceActions.
+func (in *PodGrpcChaosReplaceActions) DeepCopy() *PodGrpcChaosReplaceActions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosReplaceActions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosRule) DeepCopyInto(out *PodGrpcChaosRule) {
+	*out = *in
+	in.PodGrpcChaosBaseRule.DeepCopyInto(&out.PodGrpcChaosBaseRule)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosRule.
+func (in *PodGrpcChaosRule) DeepCopy() *PodGrpcChaosRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosSelector) DeepCopyInto(out *PodGrpcChaosSelector) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosSelector.
+func (in *PodGrpcChaosSelector) DeepCopy() *PodGrpcChaosSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosSpec) DeepCopyInto(out *PodGrpcChaosSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PodGrpcChaosRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(PodHttpChaosTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosSpec.
+func (in *PodGrpcChaosSpec) DeepCopy() *PodGrpcChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGrpcChaosStatus) DeepCopyInto(out *PodGrpcChaosStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGrpcChaosStatus.
+func (in *PodGrpcChaosStatus) DeepCopy() *PodGrpcChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGrpcChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaos) DeepCopyInto(out *PodHttpChaos) {
 	*out = *in
@@ -3834,6 +4506,13 @@ func (in *RawIPSet) DeepCopyInto(out *RawIPSet) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServiceTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.RawRuleSource = in.RawRuleSource
 }
 
@@ -4196,6 +4875,45 @@ func (in *ReorderSpec) DeepCopy() *ReorderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStatus) DeepCopyInto(out *RetryStatus) {
+	*out = *in
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStatus.
+func (in *RetryStatus) DeepCopy() *RetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStrategy.
+func (in *RetryStrategy) DeepCopy() *RetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
@@ -4318,6 +5036,52 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceTarget) DeepCopyInto(out *ServiceTarget) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceTarget.
+func (in *ServiceTarget) DeepCopy() *ServiceTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SockStressor) DeepCopyInto(out *SockStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SockStressor.
+func (in *SockStressor) DeepCopy() *SockStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(SockStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatusCheck) DeepCopyInto(out *StatusCheck) {
 	*out = *in
@@ -4680,6 +5444,21 @@ func (in *Stressors) DeepCopyInto(out *Stressors) {
 		*out = new(CPUStressor)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IOStressor != nil {
+		in, out := &in.IOStressor, &out.IOStressor
+		*out = new(IOStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HDDStressor != nil {
+		in, out := &in.HDDStressor, &out.HDDStressor
+		*out = new(HDDStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SockStressor != nil {
+		in, out := &in.SockStressor, &out.SockStressor
+		*out = new(SockStressor)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stressors.
@@ -4730,7 +5509,7 @@ func (in *TcParameter) DeepCopyInto(out *TcParameter) {
 	if in.Loss != nil {
 		in, out := &in.Loss, &out.Loss
 		*out = new(LossSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Duplicate != nil {
 		in, out := &in.Duplicate, &out.Duplicate
@@ -4777,6 +5556,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(LoopSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ConditionalBranches != nil {
 		in, out := &in.ConditionalBranches, &out.ConditionalBranches
 		*out = make([]ConditionalBranch, len(*in))
@@ -4797,6 +5581,16 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(StatusCheckSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(TemplateRef)
+		**out = **in
+	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(RetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Template.
@@ -4809,6 +5603,21 @@ func (in *Template) DeepCopy() *Template {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateRef) DeepCopyInto(out *TemplateRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateRef.
+func (in *TemplateRef) DeepCopy() *TemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeChaos) DeepCopyInto(out *TimeChaos) {
 	*out = *in
@@ -5128,6 +5937,11 @@ func (in *WorkflowNodeSpec) DeepCopyInto(out *WorkflowNodeSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(LoopSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ConditionalBranches != nil {
 		in, out := &in.ConditionalBranches, &out.ConditionalBranches
 		*out = make([]ConditionalBranch, len(*in))
@@ -5148,6 +5962,11 @@ func (in *WorkflowNodeSpec) DeepCopyInto(out *WorkflowNodeSpec) {
 		*out = new(StatusCheckSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(RetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowNodeSpec.
@@ -5183,6 +6002,23 @@ func (in *WorkflowNodeStatus) DeepCopyInto(out *WorkflowNodeStatus) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoopStatus != nil {
+		in, out := &in.LoopStatus, &out.LoopStatus
+		*out = new(LoopStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryStatus != nil {
+		in, out := &in.RetryStatus, &out.RetryStatus
+		*out = new(RetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]WorkflowNodeCondition, len(*in))
@@ -5210,6 +6046,18 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]Argument, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
@@ -5256,3 +6104,83 @@ func (in *WorkflowStatus) DeepCopy() *WorkflowStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplate) DeepCopyInto(out *WorkflowTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplate.
+func (in *WorkflowTemplate) DeepCopy() *WorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateList) DeepCopyInto(out *WorkflowTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateList.
+func (in *WorkflowTemplateList) DeepCopy() *WorkflowTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateSpec) DeepCopyInto(out *WorkflowTemplateSpec) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]Template, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateSpec.
+func (in *WorkflowTemplateSpec) DeepCopy() *WorkflowTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
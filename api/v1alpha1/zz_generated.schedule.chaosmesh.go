@@ -29,6 +29,7 @@ const (
 	ScheduleTypeBlockChaos ScheduleTemplateType = "BlockChaos"
 	ScheduleTypeDNSChaos ScheduleTemplateType = "DNSChaos"
 	ScheduleTypeGCPChaos ScheduleTemplateType = "GCPChaos"
+	ScheduleTypeGRPCChaos ScheduleTemplateType = "GRPCChaos"
 	ScheduleTypeHTTPChaos ScheduleTemplateType = "HTTPChaos"
 	ScheduleTypeIOChaos ScheduleTemplateType = "IOChaos"
 	ScheduleTypeJVMChaos ScheduleTemplateType = "JVMChaos"
@@ -48,6 +49,7 @@ var allScheduleTemplateType = []ScheduleTemplateType{
 	ScheduleTypeBlockChaos,
 	ScheduleTypeDNSChaos,
 	ScheduleTypeGCPChaos,
+	ScheduleTypeGRPCChaos,
 	ScheduleTypeHTTPChaos,
 	ScheduleTypeIOChaos,
 	ScheduleTypeJVMChaos,
@@ -83,6 +85,10 @@ func (it *ScheduleItem) SpawnNewObject(templateType ScheduleTemplateType) (Gener
 		result := GCPChaos{}
 		result.Spec = *it.GCPChaos
 		return &result, nil
+	case ScheduleTypeGRPCChaos:
+		result := GRPCChaos{}
+		result.Spec = *it.GRPCChaos
+		return &result, nil
 	case ScheduleTypeHTTPChaos:
 		result := HTTPChaos{}
 		result.Spec = *it.HTTPChaos
@@ -146,6 +152,9 @@ func (it *ScheduleItem) RestoreChaosSpec(root interface{}) error {
 	case *GCPChaos:
 		*it.GCPChaos = chaos.Spec
 		return nil
+	case *GRPCChaos:
+		*it.GRPCChaos = chaos.Spec
+		return nil
 	case *HTTPChaos:
 		*it.HTTPChaos = chaos.Spec
 		return nil
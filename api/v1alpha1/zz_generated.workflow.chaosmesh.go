@@ -29,6 +29,7 @@ const (
 	TypeBlockChaos TemplateType = "BlockChaos"
 	TypeDNSChaos TemplateType = "DNSChaos"
 	TypeGCPChaos TemplateType = "GCPChaos"
+	TypeGRPCChaos TemplateType = "GRPCChaos"
 	TypeHTTPChaos TemplateType = "HTTPChaos"
 	TypeIOChaos TemplateType = "IOChaos"
 	TypeJVMChaos TemplateType = "JVMChaos"
@@ -48,6 +49,7 @@ var allChaosTemplateType = []TemplateType{
 	TypeBlockChaos,
 	TypeDNSChaos,
 	TypeGCPChaos,
+	TypeGRPCChaos,
 	TypeHTTPChaos,
 	TypeIOChaos,
 	TypeJVMChaos,
@@ -72,6 +74,8 @@ type EmbedChaos struct {
 	// +optional
 	GCPChaos *GCPChaosSpec `json:"gcpChaos,omitempty"`
 	// +optional
+	GRPCChaos *GRPCChaosSpec `json:"grpcChaos,omitempty"`
+	// +optional
 	HTTPChaos *HTTPChaosSpec `json:"httpChaos,omitempty"`
 	// +optional
 	IOChaos *IOChaosSpec `json:"ioChaos,omitempty"`
@@ -114,6 +118,10 @@ func (it *EmbedChaos) SpawnNewObject(templateType TemplateType) (GenericChaos, e
 		result := GCPChaos{}
 		result.Spec = *it.GCPChaos
 		return &result, nil
+	case TypeGRPCChaos:
+		result := GRPCChaos{}
+		result.Spec = *it.GRPCChaos
+		return &result, nil
 	case TypeHTTPChaos:
 		result := HTTPChaos{}
 		result.Spec = *it.HTTPChaos
@@ -173,6 +181,9 @@ func (it *EmbedChaos) RestoreChaosSpec(root interface{}) error {
 	case *GCPChaos:
 		*it.GCPChaos = chaos.Spec
 		return nil
+	case *GRPCChaos:
+		*it.GRPCChaos = chaos.Spec
+		return nil
 	case *HTTPChaos:
 		*it.HTTPChaos = chaos.Spec
 		return nil
@@ -223,6 +234,9 @@ func (it *EmbedChaos) SpawnNewList(templateType TemplateType) (GenericChaosList,
 	case TypeGCPChaos:
 		result := GCPChaosList{}
 		return &result, nil
+	case TypeGRPCChaos:
+		result := GRPCChaosList{}
+		return &result, nil
 	case TypeHTTPChaos:
 		result := HTTPChaosList{}
 		return &result, nil
@@ -296,6 +310,14 @@ func (in *GCPChaosList) GetItems() []GenericChaos {
 	}
 	return result
 }
+func (in *GRPCChaosList) GetItems() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
 func (in *HTTPChaosList) GetItems() []GenericChaos {
 	var result []GenericChaos
 	for _, item := range in.Items {
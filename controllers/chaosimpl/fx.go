@@ -23,6 +23,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/blockchaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/dnschaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/gcpchaos"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/grpcchaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/httpchaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/iochaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/jvmchaos"
@@ -40,6 +41,7 @@ var AllImpl = fx.Options(
 	azurechaos.Module,
 	dnschaos.Module,
 	httpchaos.Module,
+	grpcchaos.Module,
 	iochaos.Module,
 	kernelchaos.Module,
 	networkchaos.Module,
@@ -0,0 +1,233 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcchaos
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+	v1 "k8s.io/api/core/v1"
+	k8sError "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/grpcchaos/podgrpcchaosmanager"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+const (
+	waitForApplySync   v1alpha1.Phase = "Not Injected/Wait"
+	waitForRecoverSync v1alpha1.Phase = "Injected/Wait"
+)
+
+type Impl struct {
+	client.Client
+	Log logr.Logger
+
+	builder *podgrpcchaosmanager.Builder
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	// The only possible phase to get in here is "Not Injected" or "Not Injected/Wait"
+
+	impl.Log.Info("grpcchaos Apply", "namespace", obj.GetNamespace(), "name", obj.GetName())
+	grpcchaos := obj.(*v1alpha1.GRPCChaos)
+	if grpcchaos.Status.Instances == nil {
+		grpcchaos.Status.Instances = make(map[string]int64)
+	}
+
+	record := records[index]
+	phase := record.Phase
+
+	if phase == waitForApplySync {
+		podgrpcchaos := &v1alpha1.PodGrpcChaos{}
+		namespacedName, err := controller.ParseNamespacedName(record.Id)
+		if err != nil {
+			return waitForApplySync, err
+		}
+		err = impl.Client.Get(ctx, namespacedName, podgrpcchaos)
+		if err != nil {
+			return waitForApplySync, err
+		}
+
+		if podgrpcchaos.Status.FailedMessage != "" {
+			return waitForApplySync, errors.New(podgrpcchaos.Status.FailedMessage)
+		}
+
+		if podgrpcchaos.Status.ObservedGeneration >= grpcchaos.Status.Instances[record.Id] {
+			return v1alpha1.Injected, nil
+		}
+
+		return waitForApplySync, nil
+	}
+
+	podId, err := controller.ParseNamespacedName(records[index].Id)
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+	var pod v1.Pod
+	err = impl.Client.Get(ctx, podId, &pod)
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	source := grpcchaos.Namespace + "/" + grpcchaos.Name
+	m := impl.builder.WithInit(source, types.NamespacedName{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+	})
+
+	m.T.Append(v1alpha1.PodGrpcChaosRule{
+		Source: m.Source,
+		Port:   grpcchaos.Spec.Port,
+		PodGrpcChaosBaseRule: v1alpha1.PodGrpcChaosBaseRule{
+			Target: grpcchaos.Spec.Target,
+			Selector: v1alpha1.PodGrpcChaosSelector{
+				Port:     &grpcchaos.Spec.Port,
+				Service:  grpcchaos.Spec.Service,
+				Method:   grpcchaos.Spec.Method,
+				Metadata: grpcchaos.Spec.Metadata,
+			},
+			Actions: grpcchaos.Spec.PodGrpcChaosActions,
+		},
+	})
+
+	if grpcchaos.Spec.TLS != nil {
+		m.T.Append(grpcchaos.Spec.TLS)
+	}
+
+	generationNumber, err := m.Commit(ctx)
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	// modify the custom status
+	grpcchaos.Status.Instances[record.Id] = generationNumber
+	return waitForApplySync, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	// The only possible phase to get in here is "Injected" or "Injected/Wait"
+
+	grpcchaos := obj.(*v1alpha1.GRPCChaos)
+	if grpcchaos.Status.Instances == nil {
+		grpcchaos.Status.Instances = make(map[string]int64)
+	}
+
+	record := records[index]
+	phase := record.Phase
+	if phase == waitForRecoverSync {
+		podgrpcchaos := &v1alpha1.PodGrpcChaos{}
+		namespacedName, err := controller.ParseNamespacedName(record.Id)
+		if err != nil {
+			// This error is not expected to exist
+			return waitForRecoverSync, err
+		}
+		err = impl.Client.Get(ctx, namespacedName, podgrpcchaos)
+		if err != nil {
+			// TODO: handle this error
+			if k8sError.IsNotFound(err) {
+				return v1alpha1.NotInjected, nil
+			}
+
+			if k8sError.IsForbidden(err) {
+				if strings.Contains(err.Error(), "because it is being terminated") {
+					return v1alpha1.NotInjected, nil
+				}
+			}
+
+			return waitForRecoverSync, err
+		}
+
+		if podgrpcchaos.Status.FailedMessage != "" {
+			return waitForRecoverSync, errors.New(podgrpcchaos.Status.FailedMessage)
+		}
+
+		if podgrpcchaos.Status.ObservedGeneration >= grpcchaos.Status.Instances[record.Id] {
+			return v1alpha1.NotInjected, nil
+		}
+
+		return waitForRecoverSync, nil
+	}
+
+	podId, err := controller.ParseNamespacedName(records[index].Id)
+	if err != nil {
+		// This error is not expected to exist
+		return v1alpha1.NotInjected, err
+	}
+	var pod v1.Pod
+	err = impl.Client.Get(ctx, podId, &pod)
+	if err != nil {
+		// TODO: handle this error
+		if k8sError.IsNotFound(err) {
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	source := grpcchaos.Namespace + "/" + grpcchaos.Name
+	m := impl.builder.WithInit(source, types.NamespacedName{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+	})
+
+	generationNumber, err := m.Commit(ctx)
+	if err != nil {
+		if err == podgrpcchaosmanager.ErrPodNotFound || err == podgrpcchaosmanager.ErrPodNotRunning {
+			return v1alpha1.NotInjected, nil
+		}
+
+		if k8sError.IsForbidden(err) {
+			if strings.Contains(err.Error(), "because it is being terminated") {
+				return v1alpha1.NotInjected, nil
+			}
+		}
+		return v1alpha1.Injected, err
+	}
+
+	// Now modify the custom status and phase
+	grpcchaos.Status.Instances[record.Id] = generationNumber
+	return waitForRecoverSync, nil
+}
+
+func NewImpl(c client.Client, b *podgrpcchaosmanager.Builder, log logr.Logger) *impltypes.ChaosImplPair {
+	return &impltypes.ChaosImplPair{
+		Name:   "grpcchaos",
+		Object: &v1alpha1.GRPCChaos{},
+		Impl: &Impl{
+			Client:  c,
+			Log:     log.WithName("grpcchaos"),
+			builder: b,
+		},
+		ObjectList: &v1alpha1.GRPCChaosList{},
+		Controlls:  []client.Object{&v1alpha1.PodGrpcChaos{}},
+	}
+}
+
+var Module = fx.Provide(
+	fx.Annotated{
+		Group:  "impl",
+		Target: NewImpl,
+	},
+	podgrpcchaosmanager.NewBuilder,
+)
@@ -0,0 +1,106 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podgrpcchaosmanager
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+)
+
+// PodGrpcTransaction represents a modification on podgrpcchaos
+type PodGrpcTransaction struct {
+	Steps []Step
+}
+
+// Step represents a step of PodGrpcTransaction
+type Step interface {
+	// Apply will apply an action on podgrpcchaos
+	Apply(chaos *v1alpha1.PodGrpcChaos) error
+}
+
+// Clear removes all resources with the same source
+type Clear struct {
+	Source string
+}
+
+// Apply runs this action
+func (s *Clear) Apply(chaos *v1alpha1.PodGrpcChaos) error {
+	rules := []v1alpha1.PodGrpcChaosRule{}
+	for _, rule := range chaos.Spec.Rules {
+		if rule.Source != s.Source {
+			rules = append(rules, rule)
+		}
+	}
+	chaos.Spec.Rules = rules
+	return nil
+}
+
+// Append adds an item to corresponding list in podgrpcchaos
+type Append struct {
+	Item interface{}
+}
+
+// Apply runs this action
+func (a *Append) Apply(chaos *v1alpha1.PodGrpcChaos) error {
+	switch item := a.Item.(type) {
+	case v1alpha1.PodGrpcChaosRule:
+		chaos.Spec.Rules = append(chaos.Spec.Rules, item)
+	case *v1alpha1.PodHttpChaosTLS:
+		chaos.Spec.TLS = item
+	default:
+		return errors.Wrapf(utils.ErrUnknownType, "type: %T", item)
+	}
+
+	return nil
+}
+
+// Clear will clear all related items in podgrpcchaos
+func (t *PodGrpcTransaction) Clear(source string) {
+	t.Steps = append(t.Steps, &Clear{
+		Source: source,
+	})
+}
+
+// Append adds an item to corresponding list in podgrpcchaos
+func (t *PodGrpcTransaction) Append(item interface{}) error {
+	switch item.(type) {
+	case v1alpha1.PodGrpcChaosRule:
+		t.Steps = append(t.Steps, &Append{
+			Item: item,
+		})
+	case *v1alpha1.PodHttpChaosTLS:
+		t.Steps = append(t.Steps, &Append{
+			Item: item,
+		})
+	default:
+		return errors.Wrapf(utils.ErrUnknownType, "type: %T", item)
+	}
+	return nil
+}
+
+// Apply runs every step on the chaos
+func (t *PodGrpcTransaction) Apply(chaos *v1alpha1.PodGrpcChaos) error {
+	for _, s := range t.Steps {
+		err := s.Apply(chaos)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
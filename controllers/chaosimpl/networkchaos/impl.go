@@ -45,12 +45,15 @@ func NewImpl(impl Impl) *impltypes.ChaosImplPair {
 	}
 }
 
-var Module = fx.Provide(
-	fx.Annotated{
-		Group:  "impl",
-		Target: NewImpl,
-	},
-	trafficcontrol.NewImpl,
-	partition.NewImpl,
-	podnetworkchaosmanager.NewBuilder,
+var Module = fx.Options(
+	fx.Provide(
+		fx.Annotated{
+			Group:  "impl",
+			Target: NewImpl,
+		},
+		trafficcontrol.NewImpl,
+		partition.NewImpl,
+		podnetworkchaosmanager.NewBuilder,
+	),
+	fx.Invoke(BootstrapResync),
 )
@@ -331,7 +331,17 @@ func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 	if chainDirection == v1alpha1.Input {
 		pbChainDirection = pb.Chain_INPUT
 	}
-	if len(targets)+len(externalCidrs) == 0 {
+
+	// the ipset of source pods is used when the packets are dropped on the target pods
+	portFilter := netutils.NewPortFilter(&networkchaos.Spec, chainDirection == v1alpha1.Output, ipSetPostFix == sourceIPSetPostFix)
+
+	// the target services are only available on the source pods
+	var services []v1alpha1.ServiceTarget
+	if ipSetPostFix == targetIPSetPostFix {
+		services = networkchaos.Spec.TargetServices
+	}
+
+	if len(targets)+len(externalCidrs)+len(services) == 0 {
 		impl.Log.Info("apply traffic control", "sources", m.Source)
 		m.T.Append(v1alpha1.RawIptables{
 			Name:      iptable.GenerateName(pbChainDirection, networkchaos),
@@ -340,7 +350,10 @@ func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 			RawRuleSource: v1alpha1.RawRuleSource{
 				Source: m.Source,
 			},
-			Device: device,
+			Device:           device,
+			Protocol:         portFilter.Protocol,
+			SourcePorts:      portFilter.SourcePorts,
+			DestinationPorts: portFilter.DestinationPorts,
 		})
 		return nil
 	}
@@ -360,7 +373,7 @@ func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 		}
 		targetPods = append(targetPods, pod)
 	}
-	dstIPSets := ipset.BuildIPSets(targetPods, externalCidrs, networkchaos, ipSetPostFix, m.Source)
+	dstIPSets := ipset.BuildIPSets(targetPods, externalCidrs, services, networkchaos, ipSetPostFix, m.Source)
 	dstSetIPSet := ipset.BuildSetIPSet(dstIPSets, networkchaos, ipSetPostFix, m.Source)
 
 	for _, ipSet := range dstIPSets {
@@ -376,7 +389,10 @@ func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 		RawRuleSource: v1alpha1.RawRuleSource{
 			Source: m.Source,
 		},
-		Device: device,
+		Device:           device,
+		Protocol:         portFilter.Protocol,
+		SourcePorts:      portFilter.SourcePorts,
+		DestinationPorts: portFilter.DestinationPorts,
 	})
 
 	return nil
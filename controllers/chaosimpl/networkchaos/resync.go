@@ -0,0 +1,353 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package networkchaos
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/action"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/networkchaos/podnetworkchaosmanager"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/builder"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/pod"
+)
+
+// ResyncReconciler re-applies the injected NetworkChaos when the IP of a selected pod changes,
+// e.g. the pod is recreated by a StatefulSet, so that the ipsets on the other side are kept updated.
+// The pods which are newly matched by the selectors with "all" mode are also added to the records,
+// and then injected by the records controller.
+type ResyncReconciler struct {
+	client.Client
+
+	Impl     impltypes.ChaosImpl
+	Selector *selector.Selector
+	Recorder recorder.ChaosRecorder
+	Log      logr.Logger
+}
+
+func (r *ResyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	networkchaos := &v1alpha1.NetworkChaos{}
+	if err := r.Client.Get(ctx, req.NamespacedName, networkchaos); err != nil {
+		if !apierrors.IsNotFound(err) {
+			r.Log.Error(err, "unable to get networkchaos")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if networkchaos.IsDeleted() || networkchaos.Status.Experiment.DesiredPhase != v1alpha1.RunningPhase {
+		return ctrl.Result{}, nil
+	}
+
+	// only re-sync the chaos which has been totally injected, to avoid racing with the records controller
+	records := networkchaos.Status.Experiment.Records
+	for _, record := range records {
+		if record.Phase != v1alpha1.Injected {
+			r.Log.Info("skip re-syncing the chaos which is not injected", "chaos", req.NamespacedName, "record", record.Id)
+			return ctrl.Result{}, nil
+		}
+	}
+
+	newRecords, err := r.selectNewTargets(ctx, networkchaos)
+	if err != nil {
+		r.Log.Error(err, "fail to select new targets")
+		r.Recorder.Event(networkchaos, recorder.Failed{
+			Activity: "select targets",
+			Err:      err.Error(),
+		})
+		return ctrl.Result{}, nil
+	}
+	// the injected records are re-applied with the new targets, so that their ipsets contain the new pods
+	records = append(records, newRecords...)
+
+	resynced := map[int]v1alpha1.RecordEvent{}
+	// the records with "." selector key should be re-applied before the ones with ".Target",
+	// because only the former clears the rules of this chaos on the pod
+	for _, selectorKey := range []string{".", ".Target"} {
+		for index, record := range records {
+			// the new records are left to the records controller
+			if record.SelectorKey != selectorKey || record.Phase != v1alpha1.Injected {
+				continue
+			}
+
+			changed, err := r.resyncRecord(ctx, index, records, networkchaos)
+			if err != nil {
+				r.Log.Error(err, "fail to re-sync chaos", "record", record.Id)
+				resynced[index] = *v1alpha1.NewRecordEvent(v1alpha1.TypeFailed, v1alpha1.Resync, err.Error(), metav1.Now())
+				r.Recorder.Event(networkchaos, recorder.Failed{
+					Activity: "re-sync chaos",
+					Err:      err.Error(),
+				})
+				continue
+			}
+
+			if changed {
+				resynced[index] = *v1alpha1.NewRecordEvent(v1alpha1.TypeSucceeded, v1alpha1.Resync, "", metav1.Now())
+				r.Recorder.Event(networkchaos, recorder.Resynced{
+					Id: record.Id,
+				})
+			}
+		}
+	}
+
+	if len(resynced) == 0 && len(newRecords) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	updateError := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		obj := &v1alpha1.NetworkChaos{}
+		if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+			return err
+		}
+
+		if obj.Status.Instances == nil {
+			obj.Status.Instances = make(map[string]int64)
+		}
+		for index, ev := range resynced {
+			record := records[index]
+			obj.Status.Instances[record.Id] = networkchaos.Status.Instances[record.Id]
+			for _, latest := range obj.Status.Experiment.Records {
+				if latest.Id == record.Id && latest.SelectorKey == record.SelectorKey {
+					latest.Events = append(latest.Events, ev)
+				}
+			}
+		}
+		for _, record := range newRecords {
+			if !hasRecord(obj.Status.Experiment.Records, record) {
+				obj.Status.Experiment.Records = append(obj.Status.Experiment.Records, record)
+			}
+		}
+		return r.Client.Update(ctx, obj)
+	})
+	if updateError != nil {
+		r.Log.Error(updateError, "fail to update")
+		r.Recorder.Event(networkchaos, recorder.Failed{
+			Activity: "update records",
+			Err:      updateError.Error(),
+		})
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// selectNewTargets re-runs the selectors with "all" mode, and returns the records of the pods which are not
+// selected yet. The selectors with other modes are not re-run, as the number of their targets is fixed.
+func (r *ResyncReconciler) selectNewTargets(ctx context.Context, networkchaos *v1alpha1.NetworkChaos) ([]*v1alpha1.Record, error) {
+	var newRecords []*v1alpha1.Record
+
+	selectors := networkchaos.GetSelectorSpecs()
+	for _, selectorKey := range []string{".", ".Target"} {
+		spec, ok := selectors[selectorKey].(*v1alpha1.PodSelector)
+		if !ok || spec == nil || spec.Mode != v1alpha1.AllMode {
+			continue
+		}
+
+		targets, err := r.Selector.Select(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range targets {
+			record := &v1alpha1.Record{
+				Id:          target.Id(),
+				SelectorKey: selectorKey,
+				Phase:       v1alpha1.NotInjected,
+			}
+			if hasRecord(networkchaos.Status.Experiment.Records, record) {
+				continue
+			}
+			newRecords = append(newRecords, record)
+		}
+	}
+
+	return newRecords, nil
+}
+
+func hasRecord(records []*v1alpha1.Record, record *v1alpha1.Record) bool {
+	for _, r := range records {
+		if r.Id == record.Id && r.SelectorKey == record.SelectorKey {
+			return true
+		}
+	}
+	return false
+}
+
+// resyncRecord re-applies the record, and returns whether the podnetworkchaos has been changed
+func (r *ResyncReconciler) resyncRecord(ctx context.Context, index int, records []*v1alpha1.Record, networkchaos *v1alpha1.NetworkChaos) (bool, error) {
+	record := records[index]
+	namespacedName, err := controller.ParseNamespacedName(record.Id)
+	if err != nil {
+		return false, err
+	}
+
+	previous := &v1alpha1.PodNetworkChaos{}
+	err = r.Client.Get(ctx, namespacedName, previous)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	created := apierrors.IsNotFound(err)
+
+	// the phase is ignored, because the chaos has been injected, and the changes
+	// will be synced to the pod by the podnetworkchaos controller
+	_, err = r.Impl.Apply(ctx, index, records, networkchaos)
+	if err != nil {
+		if errors.Is(err, podnetworkchaosmanager.ErrPodNotFound) || errors.Is(err, podnetworkchaosmanager.ErrPodNotRunning) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if created {
+		return true, nil
+	}
+	return networkchaos.Status.Instances[record.Id] != previous.Generation, nil
+}
+
+// podIPChangedPredicate only allows the events which assign a new IP to the pod
+var podIPChangedPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		pod, ok := e.Object.(*v1.Pod)
+		return ok && pod.Status.PodIP != ""
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldPod, ok := e.ObjectOld.(*v1.Pod)
+		if !ok {
+			return false
+		}
+		newPod, ok := e.ObjectNew.(*v1.Pod)
+		if !ok {
+			return false
+		}
+		return newPod.Status.PodIP != "" && oldPod.Status.PodIP != newPod.Status.PodIP
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// requestsForPod returns the running NetworkChaos which have selected the pod, or whose selectors
+// with "all" mode match the pod
+func requestsForPod(ctx context.Context, c client.Client, obj client.Object) ([]reconcile.Request, error) {
+	reqs := []reconcile.Request{}
+	p, ok := obj.(*v1.Pod)
+	if !ok {
+		return reqs, nil
+	}
+	id := types.NamespacedName{
+		Namespace: p.Namespace,
+		Name:      p.Name,
+	}.String()
+
+	list := &v1alpha1.NetworkChaosList{}
+	err := c.List(ctx, list)
+	if err != nil {
+		return reqs, err
+	}
+
+	for _, item := range list.Items {
+		if item.IsDeleted() || item.Status.Experiment.DesiredPhase != v1alpha1.RunningPhase {
+			continue
+		}
+
+		matched := false
+		for _, record := range item.Status.Experiment.Records {
+			if record.Id == id {
+				matched = true
+				break
+			}
+		}
+
+		for _, spec := range item.GetSelectorSpecs() {
+			if matched {
+				break
+			}
+			podSelector, ok := spec.(*v1alpha1.PodSelector)
+			if !ok || podSelector == nil || podSelector.Mode != v1alpha1.AllMode {
+				continue
+			}
+
+			matched, err = pod.CheckPodMeetSelector(ctx, c, *p, podSelector.Selector, config.ControllerCfg.ClusterScoped, config.ControllerCfg.TargetNamespace, config.ControllerCfg.EnableFilterNamespace)
+			if err != nil {
+				return reqs, err
+			}
+		}
+
+		if matched {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: item.Namespace,
+					Name:      item.Name,
+				},
+			})
+		}
+	}
+	return reqs, nil
+}
+
+// BootstrapResync sets up the controller which watches the selected pods of NetworkChaos
+func BootstrapResync(mgr ctrl.Manager, c client.Client, logger logr.Logger, recorderBuilder *recorder.RecorderBuilder, impl Impl, selector *selector.Selector) error {
+	if !config.ShouldSpawnController("networkchaos-resync") {
+		return nil
+	}
+
+	setupLog := logger.WithName("setup-networkchaos-resync")
+	delegate := action.NewMultiplexer(&impl)
+
+	return builder.Default(mgr).
+		// the NetworkChaos itself is handled by the common controllers
+		For(&v1alpha1.NetworkChaos{}, ctrlbuilder.WithPredicates(predicate.NewPredicateFuncs(func(client.Object) bool {
+			return false
+		}))).
+		Watches(&source.Kind{Type: &v1.Pod{}}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			reqs, err := requestsForPod(context.TODO(), c, obj)
+			if err != nil {
+				setupLog.Error(err, "fail to list networkchaos")
+			}
+			return reqs
+		}), ctrlbuilder.WithPredicates(podIPChangedPredicate)).
+		Named("networkchaos-resync").
+		Complete(&ResyncReconciler{
+			Client:   c,
+			Impl:     &delegate,
+			Selector: selector,
+			Recorder: recorderBuilder.Build("networkchaos-resync"),
+			Log:      logger.WithName("networkchaos-resync"),
+		})
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package networkchaos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/cmd/chaos-controller-manager/provider"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/pod"
+	. "github.com/chaos-mesh/chaos-mesh/pkg/testutils"
+)
+
+// fakeImpl records the targets of every applied record
+type fakeImpl struct {
+	applied [][]string
+}
+
+func (impl *fakeImpl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	var ids []string
+	for _, record := range records {
+		ids = append(ids, record.SelectorKey+record.Id)
+	}
+	impl.applied = append(impl.applied, ids)
+	return v1alpha1.Injected, nil
+}
+
+func (impl *fakeImpl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	return v1alpha1.NotInjected, nil
+}
+
+func newResyncTestChaos() *v1alpha1.NetworkChaos {
+	podSelector := func(app string) v1alpha1.PodSelector {
+		return v1alpha1.PodSelector{
+			Selector: v1alpha1.PodSelectorSpec{
+				GenericSelectorSpec: v1alpha1.GenericSelectorSpec{
+					Namespaces:     []string{metav1.NamespaceDefault},
+					LabelSelectors: map[string]string{"app": app},
+				},
+			},
+			Mode: v1alpha1.AllMode,
+		}
+	}
+	target := podSelector("server")
+
+	return &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "delay",
+		},
+		Spec: v1alpha1.NetworkChaosSpec{
+			PodSelector: podSelector("client"),
+			Action:      v1alpha1.DelayAction,
+			Direction:   v1alpha1.To,
+			Target:      &target,
+		},
+		Status: v1alpha1.NetworkChaosStatus{
+			ChaosStatus: v1alpha1.ChaosStatus{
+				Experiment: v1alpha1.ExperimentStatus{
+					DesiredPhase: v1alpha1.RunningPhase,
+					Records: []*v1alpha1.Record{
+						{Id: "default/client0", SelectorKey: ".", Phase: v1alpha1.Injected},
+						{Id: "default/server0", SelectorKey: ".Target", Phase: v1alpha1.Injected},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResyncNewTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	clients, _ := GenerateNPods("client", 1, PodArg{Labels: map[string]string{"app": "client"}})
+	servers, _ := GenerateNPods("server", 2, PodArg{Labels: map[string]string{"app": "server"}})
+	objs := append(clients, servers...)
+	objs = append(objs, newResyncTestChaos())
+
+	c := fake.NewClientBuilder().WithScheme(provider.NewScheme()).WithRuntimeObjects(objs...).Build()
+	impl := &fakeImpl{}
+	r := &ResyncReconciler{
+		Client: c,
+		Impl:   impl,
+		Selector: selector.New(selector.SelectorParams{
+			PodSelector: pod.New(pod.Params{Client: c}),
+		}),
+		Recorder: recorder.NewDebugRecorder(),
+		Log:      logr.Discard(),
+	}
+
+	name := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "delay"}
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: name})
+	g.Expect(err).To(BeNil())
+
+	// the injected records are re-applied with the new target, and the new target is left to the records controller
+	all := []string{".default/client0", ".Targetdefault/server0", ".Targetdefault/server1"}
+	g.Expect(impl.applied).To(Equal([][]string{all, all}))
+
+	chaos := &v1alpha1.NetworkChaos{}
+	g.Expect(c.Get(context.TODO(), name, chaos)).To(BeNil())
+	records := chaos.Status.Experiment.Records
+	g.Expect(records).To(HaveLen(3))
+	g.Expect(records[0].Events).To(HaveLen(1))
+	g.Expect(records[0].Events[0].Operation).To(Equal(v1alpha1.Resync))
+	g.Expect(records[1].Events).To(HaveLen(1))
+	g.Expect(*records[2]).To(Equal(v1alpha1.Record{
+		Id:          "default/server1",
+		SelectorKey: ".Target",
+		Phase:       v1alpha1.NotInjected,
+	}))
+
+	// the chaos is not re-synced until the new target is injected
+	impl.applied = nil
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: name})
+	g.Expect(err).To(BeNil())
+	g.Expect(impl.applied).To(BeEmpty())
+}
+
+func TestRequestsForPod(t *testing.T) {
+	g := NewWithT(t)
+
+	chaos := newResyncTestChaos()
+	c := fake.NewClientBuilder().WithScheme(provider.NewScheme()).WithRuntimeObjects(chaos).Build()
+
+	newPod := func(name string, app string) client.Object {
+		p := NewPod(PodArg{Name: name, Labels: map[string]string{"app": app}})
+		return &p
+	}
+	expected := []ctrl.Request{{NamespacedName: types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "delay"}}}
+
+	testCases := []struct {
+		name     string
+		pod      client.Object
+		expected []ctrl.Request
+	}{
+		{"recorded pod", newPod("server0", "other"), expected},
+		{"new pod matched by the target", newPod("server1", "server"), expected},
+		{"new pod matched by the selector", newPod("client1", "client"), expected},
+		{"pod not matched", newPod("other0", "other"), []ctrl.Request{}},
+	}
+	for _, tc := range testCases {
+		reqs, err := requestsForPod(context.TODO(), c, tc.pod)
+		g.Expect(err).To(BeNil(), tc.name)
+		g.Expect(reqs).To(Equal(tc.expected), tc.name)
+	}
+
+	// the selectors with other modes are not re-run
+	chaos.Spec.Target.Mode = v1alpha1.OneMode
+	g.Expect(c.Update(context.TODO(), chaos)).To(BeNil())
+	reqs, err := requestsForPod(context.TODO(), c, newPod("server1", "server"))
+	g.Expect(err).To(BeNil())
+	g.Expect(reqs).To(BeEmpty())
+}
+
+func TestPodIPChangedPredicate(t *testing.T) {
+	g := NewWithT(t)
+
+	podWithIP := func(ip string) *v1.Pod {
+		return &v1.Pod{
+			Status: v1.PodStatus{
+				PodIP: ip,
+			},
+		}
+	}
+
+	g.Expect(podIPChangedPredicate.Create(event.CreateEvent{Object: podWithIP("")})).To(BeFalse())
+	g.Expect(podIPChangedPredicate.Create(event.CreateEvent{Object: podWithIP("10.244.0.2")})).To(BeTrue())
+
+	testCases := []struct {
+		oldIP    string
+		newIP    string
+		expected bool
+	}{
+		{"", "", false},
+		{"", "10.244.0.2", true},
+		{"10.244.0.2", "10.244.0.2", false},
+		{"10.244.0.2", "10.244.0.3", true},
+		{"10.244.0.2", "", false},
+	}
+	for _, tc := range testCases {
+		g.Expect(podIPChangedPredicate.Update(event.UpdateEvent{
+			ObjectOld: podWithIP(tc.oldIP),
+			ObjectNew: podWithIP(tc.newIP),
+		})).To(Equal(tc.expected))
+	}
+
+	g.Expect(podIPChangedPredicate.Delete(event.DeleteEvent{Object: podWithIP("10.244.0.2")})).To(BeFalse())
+}
@@ -267,13 +267,25 @@ func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 		return err
 	}
 
-	if len(targets)+len(externalCidrs) == 0 {
+	// the ipset of source pods is used when the traffic control is applied on the target pods
+	portFilter := netutils.NewPortFilter(&spec, true, ipSetPostFix == sourceIPSetPostFix)
+
+	// the target services are only available on the source pods
+	var services []v1alpha1.ServiceTarget
+	if ipSetPostFix == targetIPSetPostFix {
+		services = networkchaos.Spec.TargetServices
+	}
+
+	if len(targets)+len(externalCidrs)+len(services) == 0 {
 		impl.Log.Info("apply traffic control", "sources", m.Source)
 		m.T.Append(v1alpha1.RawTrafficControl{
-			Type:        tcType,
-			TcParameter: spec.TcParameter,
-			Source:      m.Source,
-			Device:      device,
+			Type:             tcType,
+			TcParameter:      spec.TcParameter,
+			Source:           m.Source,
+			Device:           device,
+			Protocol:         portFilter.Protocol,
+			SourcePorts:      portFilter.SourcePorts,
+			DestinationPorts: portFilter.DestinationPorts,
 		})
 		return nil
 	}
@@ -294,7 +306,7 @@ func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 		targetPods = append(targetPods, pod)
 	}
 	ipSetWithTcPostFix := string(tcType[0:2]) + ipSetPostFix
-	dstIPSets := ipset.BuildIPSets(targetPods, externalCidrs, networkchaos, ipSetWithTcPostFix, m.Source)
+	dstIPSets := ipset.BuildIPSets(targetPods, externalCidrs, services, networkchaos, ipSetWithTcPostFix, m.Source)
 	dstSetIPSet := ipset.BuildSetIPSet(dstIPSets, networkchaos, ipSetWithTcPostFix, m.Source)
 	impl.Log.Info("apply traffic control with filter", "sources", m.Source, "setIpset", dstSetIPSet, "ipSets", dstIPSets)
 
@@ -305,11 +317,14 @@ func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 	m.T.Append(dstSetIPSet)
 
 	m.T.Append(v1alpha1.RawTrafficControl{
-		Type:        tcType,
-		TcParameter: spec.TcParameter,
-		Source:      m.Source,
-		IPSet:       dstSetIPSet.Name,
-		Device:      device,
+		Type:             tcType,
+		TcParameter:      spec.TcParameter,
+		Source:           m.Source,
+		IPSet:            dstSetIPSet.Name,
+		Device:           device,
+		Protocol:         portFilter.Protocol,
+		SourcePorts:      portFilter.SourcePorts,
+		DestinationPorts: portFilter.DestinationPorts,
 	})
 
 	return nil
@@ -0,0 +1,99 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package containerpause
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	client.Client
+
+	Log logr.Logger
+
+	decoder *utils.ContainerRecordDecoder
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	containerId := decodedContainer.ContainerId
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	if _, err = pbClient.ContainerPause(ctx, &pb.ContainerRequest{
+		Action: &pb.ContainerAction{
+			Action: pb.ContainerAction_PAUSE,
+		},
+		ContainerId: containerId,
+	}); err != nil {
+		impl.Log.Error(err, "pause container error", "containerID", containerId)
+		return v1alpha1.NotInjected, err
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	containerId := decodedContainer.ContainerId
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		if errors.Is(err, utils.ErrContainerNotFound) {
+			// pretend the disappeared container has been recovered
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	if _, err = pbClient.ContainerUnpause(ctx, &pb.ContainerRequest{
+		Action: &pb.ContainerAction{
+			Action: pb.ContainerAction_UNPAUSE,
+		},
+		ContainerId: containerId,
+	}); err != nil {
+		impl.Log.Error(err, "unpause container error", "containerID", containerId)
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.NotInjected, nil
+}
+
+func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDecoder) *Impl {
+	return &Impl{
+		Client:  c,
+		Log:     log.WithName("containerpause"),
+		decoder: decoder,
+	}
+}
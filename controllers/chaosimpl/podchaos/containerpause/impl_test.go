@@ -0,0 +1,123 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package containerpause
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/cmd/chaos-controller-manager/provider"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	. "github.com/chaos-mesh/chaos-mesh/controllers/test"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+	. "github.com/chaos-mesh/chaos-mesh/pkg/testutils"
+)
+
+func newTestImpl() *Impl {
+	pod := NewPod(PodArg{
+		Name: "p0",
+		ContainerStatus: v1.ContainerStatus{
+			Name:        "c0",
+			ContainerID: "containerd://c0",
+		},
+	})
+	c := fake.NewClientBuilder().WithScheme(provider.NewScheme()).WithRuntimeObjects(&pod).Build()
+
+	return NewImpl(c, logr.Discard(), utils.NewContainerRecordDecoder(c, &chaosdaemon.ChaosDaemonClientBuilder{}))
+}
+
+func TestApply(t *testing.T) {
+	defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+	g := NewWithT(t)
+
+	impl := newTestImpl()
+	chaos := &v1alpha1.PodChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "pause",
+		},
+	}
+
+	t.Run("pause container", func(t *testing.T) {
+		records := []*v1alpha1.Record{{Id: "default/p0/c0", Phase: v1alpha1.NotInjected}}
+		phase, err := impl.Apply(context.TODO(), 0, records, chaos)
+		g.Expect(err).To(BeNil())
+		g.Expect(phase).To(Equal(v1alpha1.Injected))
+	})
+
+	t.Run("fail to pause container", func(t *testing.T) {
+		defer mock.With("MockContainerPauseError", errors.New("container is not running"))()
+
+		records := []*v1alpha1.Record{{Id: "default/p0/c0", Phase: v1alpha1.NotInjected}}
+		phase, err := impl.Apply(context.TODO(), 0, records, chaos)
+		g.Expect(err).To(MatchError("container is not running"))
+		g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+	})
+
+	t.Run("container not found", func(t *testing.T) {
+		for _, id := range []string{"default/p0/c1", "default/p1/c0"} {
+			records := []*v1alpha1.Record{{Id: id, Phase: v1alpha1.NotInjected}}
+			phase, err := impl.Apply(context.TODO(), 0, records, chaos)
+			g.Expect(errors.Is(err, utils.ErrContainerNotFound)).To(BeTrue())
+			g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+		}
+	})
+}
+
+func TestRecover(t *testing.T) {
+	defer mock.With("MockChaosDaemonClient", &MockChaosDaemonClient{})()
+	g := NewWithT(t)
+
+	impl := newTestImpl()
+	chaos := &v1alpha1.PodChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "pause",
+		},
+	}
+
+	t.Run("unpause container", func(t *testing.T) {
+		records := []*v1alpha1.Record{{Id: "default/p0/c0", Phase: v1alpha1.Injected}}
+		phase, err := impl.Recover(context.TODO(), 0, records, chaos)
+		g.Expect(err).To(BeNil())
+		g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+	})
+
+	t.Run("fail to unpause container", func(t *testing.T) {
+		defer mock.With("MockContainerUnpauseError", errors.New("connection refused"))()
+
+		records := []*v1alpha1.Record{{Id: "default/p0/c0", Phase: v1alpha1.Injected}}
+		phase, err := impl.Recover(context.TODO(), 0, records, chaos)
+		g.Expect(err).To(MatchError("connection refused"))
+		g.Expect(phase).To(Equal(v1alpha1.Injected))
+	})
+
+	t.Run("container disappeared", func(t *testing.T) {
+		records := []*v1alpha1.Record{{Id: "default/p1/c0", Phase: v1alpha1.Injected}}
+		phase, err := impl.Recover(context.TODO(), 0, records, chaos)
+		g.Expect(err).To(BeNil())
+		g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+	})
+}
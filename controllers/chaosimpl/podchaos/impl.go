@@ -21,17 +21,21 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/action"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/containerkill"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/containerpause"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/podfailure"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/podkill"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/processkill"
 	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
 )
 
 type Impl struct {
 	fx.In
 
-	PodKill       *podkill.Impl       `action:"pod-kill"`
-	PodFailure    *podfailure.Impl    `action:"pod-failure"`
-	ContainerKill *containerkill.Impl `action:"container-kill"`
+	PodKill        *podkill.Impl        `action:"pod-kill"`
+	PodFailure     *podfailure.Impl     `action:"pod-failure"`
+	ContainerKill  *containerkill.Impl  `action:"container-kill"`
+	ContainerPause *containerpause.Impl `action:"container-pause"`
+	ProcessKill    *processkill.Impl    `action:"process-kill"`
 }
 
 func NewImpl(impl Impl) *impltypes.ChaosImplPair {
@@ -51,4 +55,6 @@ var Module = fx.Provide(
 	podkill.NewImpl,
 	podfailure.NewImpl,
 	containerkill.NewImpl,
+	containerpause.NewImpl,
+	processkill.NewImpl,
 )
@@ -0,0 +1,95 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package processkill
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	client.Client
+
+	Log logr.Logger
+
+	decoder *utils.ContainerRecordDecoder
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	containerId := decodedContainer.ContainerId
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	podchaos := obj.(*v1alpha1.PodChaos)
+	signal := podchaos.Spec.Signal
+	if signal == 0 {
+		signal = v1alpha1.DefaultProcessKillSignal
+	}
+
+	resp, err := pbClient.KillProcesses(ctx, &pb.KillProcessesRequest{
+		ContainerId:    containerId,
+		NamePattern:    podchaos.Spec.ProcessName,
+		CmdlinePattern: podchaos.Spec.ProcessCmdline,
+		Signal:         uint32(signal),
+	})
+	if err != nil {
+		impl.Log.Error(err, "kill processes error", "containerID", containerId)
+		return v1alpha1.NotInjected, err
+	}
+
+	killed := make([]v1alpha1.KilledProcess, 0, len(resp.Processes))
+	for _, process := range resp.Processes {
+		killed = append(killed, v1alpha1.KilledProcess{
+			Pid:     int64(process.Pid),
+			Command: process.Command,
+		})
+	}
+	if podchaos.Status.Instances == nil {
+		podchaos.Status.Instances = make(map[string]v1alpha1.PodChaosInstance)
+	}
+	podchaos.Status.Instances[records[index].Id] = v1alpha1.PodChaosInstance{
+		KilledProcesses: killed,
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	return v1alpha1.NotInjected, nil
+}
+
+func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDecoder) *Impl {
+	return &Impl{
+		Client:  c,
+		Log:     log.WithName("processkill"),
+		decoder: decoder,
+	}
+}
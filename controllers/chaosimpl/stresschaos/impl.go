@@ -65,8 +65,9 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 	stressors := stresschaos.Spec.StressngStressors
 	cpuStressors := ""
 	memoryStressors := ""
+	ioStressors := ""
 	if len(stressors) == 0 {
-		cpuStressors, memoryStressors, err = stresschaos.Spec.Stressors.Normalize()
+		cpuStressors, memoryStressors, ioStressors, err = stresschaos.Spec.Stressors.Normalize()
 		if err != nil {
 			impl.Log.Info("fail to ")
 			// TODO: add an event here
@@ -79,10 +80,25 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		Target:          containerId,
 		CpuStressors:    cpuStressors,
 		MemoryStressors: memoryStressors,
+		IoStressors:     ioStressors,
 		EnterNS:         true,
 	}
-	if stresschaos.Spec.Stressors.MemoryStressor != nil {
-		req.OomScoreAdj = int32(stresschaos.Spec.Stressors.MemoryStressor.OOMScoreAdj)
+	if cpuStressor := stresschaos.Spec.Stressors.CPUStressor; len(stressors) == 0 && cpuStressor != nil && cpuStressor.QuotaPercent != nil {
+		req.CpuQuotaPercent = int32(*cpuStressor.QuotaPercent)
+	}
+	if memoryStressor := stresschaos.Spec.Stressors.MemoryStressor; memoryStressor != nil {
+		req.OomScoreAdj = int32(memoryStressor.OOMScoreAdj)
+
+		if memoryStressor.LimitPercent != nil {
+			req.MemoryLimitPercent = int32(*memoryStressor.LimitPercent)
+			if memoryStressor.Ramp != nil && len(memoryStressor.Ramp.Duration) == 0 {
+				req.MemoryRampStep = memoryStressor.Ramp.Step
+				req.MemoryRampInterval = memoryStressor.Ramp.Interval
+			}
+		}
+	}
+	if stresschaos.Spec.Stressors.HDDStressor != nil {
+		req.HddPath = stresschaos.Spec.Stressors.HDDStressor.Path
 	}
 	res, err := pbClient.ExecStressors(ctx, &req)
 
@@ -64,12 +64,21 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 
 	sec, nsec := secAndNSecFromDuration(duration)
 
-	impl.Log.Info("setting time shift", "mask", mask, "sec", sec, "nsec", nsec, "containerId", containerId)
+	var driftRate time.Duration
+	if len(timechaos.Spec.ClockDriftRate) > 0 {
+		driftRate, err = time.ParseDuration(timechaos.Spec.ClockDriftRate)
+		if err != nil {
+			return v1alpha1.NotInjected, err
+		}
+	}
+
+	impl.Log.Info("setting time shift", "mask", mask, "sec", sec, "nsec", nsec, "driftRate", driftRate, "containerId", containerId)
 	_, err = pbClient.SetTimeOffset(ctx, &pb.TimeRequest{
 		ContainerId:      containerId,
 		Sec:              sec,
 		Nsec:             nsec,
 		ClkIdsMask:       mask,
+		DriftRate:        driftRate.Nanoseconds(),
 		Uid:              string(obj.GetUID()) + string(decodedContainer.Pod.GetUID()),
 		PodContainerName: fmt.Sprintf("%s:%s", decodedContainer.Pod.GetUID(), decodedContainer.ContainerName),
 	})
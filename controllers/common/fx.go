@@ -157,7 +157,7 @@ func Bootstrap(params Params) error {
 // PickChildCRDPredicate allows events to trigger the Reconcile of Chaos CRD,
 // for example:
 // Reconcile of IOChaos could be triggered by changes on PodIOChaos.
-// For now, we have PodHttpChaos/PodIOChaos/PodNetworkChaos which require to follow this pattern.
+// For now, we have PodHttpChaos/PodGrpcChaos/PodIOChaos/PodNetworkChaos which require to follow this pattern.
 type PickChildCRDPredicate struct {
 	predicate.Funcs
 }
@@ -165,7 +165,7 @@ type PickChildCRDPredicate struct {
 // Update implements UpdateEvent filter for child CRD.
 func (PickChildCRDPredicate) Update(e event.UpdateEvent) bool {
 	switch e.ObjectNew.(type) {
-	case *v1alpha1.PodHttpChaos, *v1alpha1.PodIOChaos, *v1alpha1.PodNetworkChaos:
+	case *v1alpha1.PodHttpChaos, *v1alpha1.PodGrpcChaos, *v1alpha1.PodIOChaos, *v1alpha1.PodNetworkChaos:
 		return true
 	}
 	return false
@@ -23,6 +23,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/clusterregistry"
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/remotechaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/remotecluster"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podgrpcchaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podhttpchaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podiochaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos"
@@ -42,6 +43,7 @@ var Module = fx.Options(
 	),
 	fx.Invoke(common.Bootstrap),
 	fx.Invoke(podhttpchaos.Bootstrap),
+	fx.Invoke(podgrpcchaos.Bootstrap),
 	fx.Invoke(podnetworkchaos.Bootstrap),
 	fx.Invoke(podiochaos.Bootstrap),
 	fx.Invoke(wfcontrollers.BootstrapWorkflowControllers),
@@ -140,6 +140,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		rules = append(rules, rule.PodHttpChaosBaseRule)
 	}
 
+	err = r.checkGrpcChaos(ctx, req.NamespacedName, proxyPortsMap)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to apply for pod %s/%s", pod.Namespace, pod.Name)
+		r.Recorder.Event(obj, "Warning", "Failed", err.Error())
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	var proxyPorts []uint32
 	for port := range proxyPortsMap {
 		proxyPorts = append(proxyPorts, port)
@@ -247,3 +254,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	return ctrl.Result{}, nil
 }
+
+// checkGrpcChaos returns an error if any of the proxyPorts is proxied by the applied grpc chaos of the pod,
+// as the connections to a port can only be redirected to one proxy.
+func (r *Reconciler) checkGrpcChaos(ctx context.Context, key types.NamespacedName, proxyPorts map[uint32]bool) error {
+	grpcChaos := &v1alpha1.PodGrpcChaos{}
+	if err := r.Client.Get(ctx, key, grpcChaos); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, "get grpc chaos")
+	}
+
+	// the rules of a failed grpc chaos are not applied
+	if grpcChaos.Status.ObservedGeneration == 0 || grpcChaos.Status.FailedMessage != "" {
+		return nil
+	}
+
+	for _, rule := range grpcChaos.Spec.Rules {
+		if proxyPorts[uint32(rule.Port)] {
+			return errors.Errorf("port %d is proxied by grpc chaos, http chaos cannot be applied to it at the same time", rule.Port)
+		}
+	}
+	return nil
+}
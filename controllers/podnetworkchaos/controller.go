@@ -17,9 +17,11 @@ package podnetworkchaos
 
 import (
 	"context"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -51,6 +53,10 @@ type Reconciler struct {
 	Log                      logr.Logger
 	AllowHostNetworkTesting  bool
 	ChaosDaemonClientBuilder *chaosdaemon.ChaosDaemonClientBuilder
+
+	// appliedIPSets records the ipsets which have been flushed into the pods
+	// referencing services, so that they can be re-synced when the endpoints change.
+	appliedIPSets sync.Map
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -59,6 +65,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
 		if apierrors.IsNotFound(err) {
 			r.Log.Info("chaos not found")
+			r.appliedIPSets.Delete(req.NamespacedName)
 		} else {
 			// TODO: handle this error
 			r.Log.Error(err, "unable to get chaos")
@@ -67,6 +74,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if obj.ObjectMeta.Generation <= obj.Status.ObservedGeneration && obj.Status.FailedMessage == "" {
+		if hasServices(obj) {
+			return r.resyncIPSets(ctx, req, obj)
+		}
+
 		r.Log.Info("the target pod has been up to date", "pod", obj.Namespace+"/"+obj.Name)
 		return ctrl.Result{}, nil
 	}
@@ -178,26 +189,135 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, nil
 }
 
+// resyncIPSets re-resolves the services referenced by the ipsets of an up-to-date
+// podnetworkchaos, and flushes the ipsets again if the resolved addresses changed.
+func (r *Reconciler) resyncIPSets(ctx context.Context, req ctrl.Request, obj *v1alpha1.PodNetworkChaos) (ctrl.Result, error) {
+	ipsets, err := r.buildIPSets(ctx, obj)
+	if err != nil {
+		r.Log.Error(err, "fail to resolve services", "pod", obj.Namespace+"/"+obj.Name)
+		r.Recorder.Event(obj, recorder.Failed{
+			Activity: "resolve services",
+			Err:      err.Error(),
+		})
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if applied, ok := r.appliedIPSets.Load(req.NamespacedName); ok && ipsetsEqual(applied.([]*pb.IPSet), ipsets) {
+		r.Log.Info("the target pod has been up to date", "pod", obj.Namespace+"/"+obj.Name)
+		return ctrl.Result{}, nil
+	}
+
+	r.Log.Info("re-syncing ipsets with services", "pod", obj.Namespace+"/"+obj.Name)
+
+	pod := &corev1.Pod{}
+	err = r.Client.Get(ctx, req.NamespacedName, pod)
+	if err != nil {
+		r.Log.Error(err, "fail to find pod")
+		return ctrl.Result{}, nil
+	}
+
+	pbClient, err := r.ChaosDaemonClientBuilder.Build(ctx, pod, &req.NamespacedName)
+	if err != nil {
+		r.Recorder.Event(obj, recorder.Failed{
+			Activity: "create chaos daemon client",
+			Err:      err.Error(),
+		})
+		return ctrl.Result{Requeue: true}, nil
+	}
+	defer pbClient.Close()
+
+	err = ipset.FlushIPSets(ctx, pbClient, pod, ipsets)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to apply for pod %s/%s", pod.Namespace, pod.Name)
+		r.Log.Error(err, "fail to set ipsets")
+		r.Recorder.Event(obj, recorder.Failed{
+			Activity: "set ipsets",
+			Err:      err.Error(),
+		})
+		return ctrl.Result{Requeue: true}, nil
+	}
+	r.appliedIPSets.Store(req.NamespacedName, ipsets)
+
+	r.Recorder.Event(obj, recorder.Updated{
+		Field: "ipsets",
+	})
+	return ctrl.Result{}, nil
+}
+
 // SetIPSets sets ipset on pod
 func (r *Reconciler) SetIPSets(ctx context.Context, pod *corev1.Pod, chaos *v1alpha1.PodNetworkChaos, chaosdaemonClient chaosdaemonclient.ChaosDaemonClientInterface) error {
+	ipsets, err := r.buildIPSets(ctx, chaos)
+	if err != nil {
+		return err
+	}
+
+	err = ipset.FlushIPSets(ctx, chaosdaemonClient, pod, ipsets)
+	if err != nil {
+		return err
+	}
+
+	key := types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name}
+	if hasServices(chaos) {
+		r.appliedIPSets.Store(key, ipsets)
+	} else {
+		r.appliedIPSets.Delete(key)
+	}
+	return nil
+}
+
+// buildIPSets converts the ipsets in spec into protobuf, with the services resolved
+func (r *Reconciler) buildIPSets(ctx context.Context, chaos *v1alpha1.PodNetworkChaos) ([]*pb.IPSet, error) {
 	ipsets := []*pb.IPSet{}
-	for _, ipset := range chaos.Spec.IPSets {
+	for _, rawIPSet := range chaos.Spec.IPSets {
+		cidrs := append([]string{}, rawIPSet.Cidrs...)
+		rawCidrAndPorts := append([]v1alpha1.CidrAndPort{}, rawIPSet.CidrAndPorts...)
+
+		if len(rawIPSet.Services) > 0 {
+			serviceCidrs, serviceCidrAndPorts, err := ipset.ResolveServices(ctx, r.Client, rawIPSet.Services)
+			if err != nil {
+				return nil, err
+			}
+			cidrs = append(cidrs, serviceCidrs...)
+			rawCidrAndPorts = append(rawCidrAndPorts, serviceCidrAndPorts...)
+		}
+
 		cidrAndPorts := []*pb.CidrAndPort{}
-		for _, cidrAndPort := range ipset.CidrAndPorts {
+		for _, cidrAndPort := range rawCidrAndPorts {
 			cidrAndPorts = append(cidrAndPorts, &pb.CidrAndPort{
 				Cidr: cidrAndPort.Cidr,
 				Port: uint32(cidrAndPort.Port),
 			})
 		}
 		ipsets = append(ipsets, &pb.IPSet{
-			Name:         ipset.Name,
-			Type:         string(ipset.IPSetType),
-			Cidrs:        ipset.Cidrs,
+			Name:         rawIPSet.Name,
+			Type:         string(rawIPSet.IPSetType),
+			Cidrs:        cidrs,
 			CidrAndPorts: cidrAndPorts,
-			SetNames:     ipset.SetNames,
+			SetNames:     rawIPSet.SetNames,
 		})
 	}
-	return ipset.FlushIPSets(ctx, chaosdaemonClient, pod, ipsets)
+	return ipsets, nil
+}
+
+func hasServices(chaos *v1alpha1.PodNetworkChaos) bool {
+	for _, ipset := range chaos.Spec.IPSets {
+		if len(ipset.Services) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func ipsetsEqual(a, b []*pb.IPSet) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 // SetIptables sets iptables on pod
@@ -215,11 +335,14 @@ func (r *Reconciler) SetIptables(ctx context.Context, pod *corev1.Pod, chaos *v1
 			return err
 		}
 		chains = append(chains, &pb.Chain{
-			Name:      chain.Name,
-			Ipsets:    chain.IPSets,
-			Direction: direction,
-			Target:    "DROP",
-			Device:    chain.Device,
+			Name:             chain.Name,
+			Ipsets:           chain.IPSets,
+			Direction:        direction,
+			Target:           "DROP",
+			Device:           chain.Device,
+			Protocol:         chain.Protocol,
+			SourcePorts:      chain.SourcePorts,
+			DestinationPorts: chain.DestinationPorts,
 		})
 	}
 	return iptable.SetIptablesChains(ctx, chaosdaemonClient, pod, chains)
@@ -235,10 +358,13 @@ func (r *Reconciler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha
 				return err
 			}
 			tcs = append(tcs, &pb.Tc{
-				Type:   pb.Tc_BANDWIDTH,
-				Tbf:    tbf,
-				Ipset:  tc.IPSet,
-				Device: tc.Device,
+				Type:       pb.Tc_BANDWIDTH,
+				Tbf:        tbf,
+				Ipset:      tc.IPSet,
+				Device:     tc.Device,
+				Protocol:   tc.Protocol,
+				SourcePort: tc.SourcePorts,
+				EgressPort: tc.DestinationPorts,
 			})
 		} else if tc.Type == v1alpha1.Netem {
 			netem, err := mergeNetem(tc.TcParameter)
@@ -246,10 +372,13 @@ func (r *Reconciler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha
 				return err
 			}
 			tcs = append(tcs, &pb.Tc{
-				Type:   pb.Tc_NETEM,
-				Netem:  netem,
-				Ipset:  tc.IPSet,
-				Device: tc.Device,
+				Type:       pb.Tc_NETEM,
+				Netem:      netem,
+				Ipset:      tc.IPSet,
+				Device:     tc.Device,
+				Protocol:   tc.Protocol,
+				SourcePort: tc.SourcePorts,
+				EgressPort: tc.DestinationPorts,
 			})
 		} else {
 			return errors.New("unknown tc type")
@@ -16,13 +16,21 @@
 package podnetworkchaos
 
 import (
+	"context"
 	"reflect"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/config"
@@ -31,24 +39,59 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 )
 
-func Bootstrap(mgr ctrl.Manager, client client.Client, logger logr.Logger, b *chaosdaemon.ChaosDaemonClientBuilder, recorderBuilder *recorder.RecorderBuilder) error {
+func Bootstrap(mgr ctrl.Manager, c client.Client, logger logr.Logger, b *chaosdaemon.ChaosDaemonClientBuilder, recorderBuilder *recorder.RecorderBuilder) error {
 	if !config.ShouldSpawnController("podnetworkchaos") {
 		return nil
 	}
 
+	setupLog := logger.WithName("setup-podnetworkchaos")
+
+	// the podnetworkchaos referencing a service should be reconciled when
+	// the service or its endpoints change, to keep the ipsets updated
+	mapServiceToRequests := func(namespace string, name string) []reconcile.Request {
+		reqs := []reconcile.Request{}
+		if name == "" {
+			return reqs
+		}
+
+		list := &v1alpha1.PodNetworkChaosList{}
+		err := c.List(context.TODO(), list)
+		if err != nil {
+			setupLog.Error(err, "fail to list podnetworkchaos")
+			return reqs
+		}
+
+		for _, item := range list.Items {
+			if referencesService(&item, namespace, name) {
+				reqs = append(reqs, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: item.Namespace,
+						Name:      item.Name,
+					},
+				})
+			}
+		}
+		return reqs
+	}
+
 	return builder.Default(mgr).
-		For(&v1alpha1.PodNetworkChaos{}).
-		Named("podnetworkchaos").
-		WithEventFilter(predicate.Funcs{
+		For(&v1alpha1.PodNetworkChaos{}, ctrlbuilder.WithPredicates(predicate.Funcs{
 			UpdateFunc: func(e event.UpdateEvent) bool {
 				oldObj := e.ObjectOld.(*v1alpha1.PodNetworkChaos)
 				newObj := e.ObjectNew.(*v1alpha1.PodNetworkChaos)
 
 				return !reflect.DeepEqual(oldObj.Spec, newObj.Spec)
 			},
-		}).
+		})).
+		Watches(&source.Kind{Type: &corev1.Service{}}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			return mapServiceToRequests(obj.GetNamespace(), obj.GetName())
+		})).
+		Watches(&source.Kind{Type: &discoveryv1.EndpointSlice{}}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			return mapServiceToRequests(obj.GetNamespace(), obj.GetLabels()[discoveryv1.LabelServiceName])
+		})).
+		Named("podnetworkchaos").
 		Complete(&Reconciler{
-			Client:   client,
+			Client:   c,
 			Log:      logger.WithName("podnetworkchaos"),
 			Recorder: recorderBuilder.Build("podnetworkchaos"),
 
@@ -57,3 +100,14 @@ func Bootstrap(mgr ctrl.Manager, client client.Client, logger logr.Logger, b *ch
 			ChaosDaemonClientBuilder: b,
 		})
 }
+
+func referencesService(chaos *v1alpha1.PodNetworkChaos, namespace string, name string) bool {
+	for _, ipset := range chaos.Spec.IPSets {
+		for _, service := range ipset.Services {
+			if service.Namespace == namespace && service.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -33,12 +33,24 @@ import (
 var log = ctrl.Log.WithName("ipset")
 
 // BuildIPSets builds IP sets with provided pod ip list.
-func BuildIPSets(pods []v1.Pod, externalCidrs []v1alpha1.CidrAndPort, networkchaos *v1alpha1.NetworkChaos, namePostFix string, source string) []v1alpha1.RawIPSet {
+// The services are resolved into IPs by the podnetworkchaos controller, so that
+// the IP sets can be kept updated with the endpoints of the services.
+func BuildIPSets(pods []v1.Pod, externalCidrs []v1alpha1.CidrAndPort, services []v1alpha1.ServiceTarget, networkchaos *v1alpha1.NetworkChaos, namePostFix string, source string) []v1alpha1.RawIPSet {
 	netName := GenerateIPSetName(networkchaos, "net_"+namePostFix)
 	netPortName := GenerateIPSetName(networkchaos, "netport_"+namePostFix)
 
 	cidrs := []string{}
 	cidrAndPorts := []v1alpha1.CidrAndPort{}
+	netServices := []v1alpha1.ServiceTarget{}
+	netPortServices := []v1alpha1.ServiceTarget{}
+
+	for _, service := range services {
+		if service.Port == nil {
+			netServices = append(netServices, service)
+		} else {
+			netPortServices = append(netPortServices, service)
+		}
+	}
 
 	for _, cidr := range externalCidrs {
 		if cidr.Port == 0 {
@@ -59,6 +71,7 @@ func BuildIPSets(pods []v1.Pod, externalCidrs []v1alpha1.CidrAndPort, networkcha
 			Name:      netName,
 			IPSetType: v1alpha1.NetIPSet,
 			Cidrs:     cidrs,
+			Services:  netServices,
 			RawRuleSource: v1alpha1.RawRuleSource{
 				Source: source,
 			},
@@ -67,6 +80,7 @@ func BuildIPSets(pods []v1.Pod, externalCidrs []v1alpha1.CidrAndPort, networkcha
 			Name:         netPortName,
 			IPSetType:    v1alpha1.NetPortIPSet,
 			CidrAndPorts: cidrAndPorts,
+			Services:     netPortServices,
 			RawRuleSource: v1alpha1.RawRuleSource{
 				Source: source,
 			},
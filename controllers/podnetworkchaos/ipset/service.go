@@ -0,0 +1,140 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ipset
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos/netutils"
+)
+
+// ResolveServices resolves the cluster IP and the ready endpoints of the services.
+// The services without port are resolved into cidrs, and the ones with port are
+// resolved into cidr and ports. A service which doesn't exist is resolved into nothing.
+func ResolveServices(ctx context.Context, c client.Reader, services []v1alpha1.ServiceTarget) ([]string, []v1alpha1.CidrAndPort, error) {
+	cidrs := []string{}
+	cidrAndPorts := []v1alpha1.CidrAndPort{}
+
+	for _, target := range services {
+		var service v1.Service
+		err := c.Get(ctx, types.NamespacedName{
+			Namespace: target.Namespace,
+			Name:      target.Name,
+		}, &service)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("target service not found", "namespace", target.Namespace, "name", target.Name)
+				continue
+			}
+			return nil, nil, err
+		}
+
+		var servicePort *v1.ServicePort
+		if target.Port != nil {
+			for i := range service.Spec.Ports {
+				if service.Spec.Ports[i].Port == *target.Port {
+					servicePort = &service.Spec.Ports[i]
+					break
+				}
+			}
+			if servicePort == nil {
+				return nil, nil, errors.Errorf("port %d not found in service %s/%s", *target.Port, target.Namespace, target.Name)
+			}
+		}
+
+		var slices discoveryv1.EndpointSliceList
+		err = c.List(ctx, &slices, client.InNamespace(target.Namespace), client.MatchingLabels{
+			discoveryv1.LabelServiceName: target.Name,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if servicePort == nil {
+			if hasClusterIP(&service) {
+				cidrs = append(cidrs, netutils.IPToCidr(service.Spec.ClusterIP))
+			}
+			for _, slice := range slices.Items {
+				for _, ip := range readyAddresses(&slice) {
+					cidrs = append(cidrs, netutils.IPToCidr(ip))
+				}
+			}
+			continue
+		}
+
+		if hasClusterIP(&service) {
+			cidrAndPorts = append(cidrAndPorts, v1alpha1.CidrAndPort{
+				Cidr: netutils.IPToCidr(service.Spec.ClusterIP),
+				Port: uint16(servicePort.Port),
+			})
+		}
+		for _, slice := range slices.Items {
+			// the ports of endpoints are matched with the port of service by name
+			var endpointPort *int32
+			for _, port := range slice.Ports {
+				name := ""
+				if port.Name != nil {
+					name = *port.Name
+				}
+				if name == servicePort.Name && port.Port != nil {
+					endpointPort = port.Port
+					break
+				}
+			}
+			if endpointPort == nil {
+				continue
+			}
+
+			for _, ip := range readyAddresses(&slice) {
+				cidrAndPorts = append(cidrAndPorts, v1alpha1.CidrAndPort{
+					Cidr: netutils.IPToCidr(ip),
+					Port: uint16(*endpointPort),
+				})
+			}
+		}
+	}
+
+	return cidrs, cidrAndPorts, nil
+}
+
+func hasClusterIP(service *v1.Service) bool {
+	return service.Spec.ClusterIP != "" && service.Spec.ClusterIP != v1.ClusterIPNone
+}
+
+// readyAddresses returns the addresses of the endpoints which are not marked as not ready.
+// Only IPv4 addresses are returned, as the ipset only supports IPv4 for now.
+func readyAddresses(slice *discoveryv1.EndpointSlice) []string {
+	if slice.AddressType != discoveryv1.AddressTypeIPv4 {
+		return nil
+	}
+
+	addresses := []string{}
+	for _, endpoint := range slice.Endpoints {
+		if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+			continue
+		}
+		addresses = append(addresses, endpoint.Addresses...)
+	}
+	return addresses
+}
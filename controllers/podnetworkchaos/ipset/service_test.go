@@ -0,0 +1,136 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ipset
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestResolveServices(t *testing.T) {
+	g := NewWithT(t)
+
+	httpName := "http"
+	httpPort := int32(8080)
+	servicePort := int32(80)
+	unknownPort := int32(81)
+	notReady := false
+
+	objects := []runtime.Object{
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+			Spec: v1.ServiceSpec{
+				ClusterIP: "10.96.0.10",
+				Ports: []v1.ServicePort{
+					{Name: httpName, Port: servicePort},
+				},
+			},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "web-abcde",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "web"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.244.0.2"}},
+				{Addresses: []string{"10.244.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}},
+			},
+			Ports: []discoveryv1.EndpointPort{
+				{Name: &httpName, Port: &httpPort},
+			},
+		},
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "headless"},
+			Spec: v1.ServiceSpec{
+				ClusterIP: v1.ClusterIPNone,
+			},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "headless-abcde",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "headless"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.244.0.4"}},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(objects...).Build()
+
+	t.Run("service without port", func(t *testing.T) {
+		cidrs, cidrAndPorts, err := ResolveServices(context.Background(), c, []v1alpha1.ServiceTarget{
+			{Namespace: "default", Name: "web"},
+		})
+
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(cidrs).Should(Equal([]string{"10.96.0.10/32", "10.244.0.2/32"}))
+		g.Expect(cidrAndPorts).Should(BeEmpty())
+	})
+
+	t.Run("service with port", func(t *testing.T) {
+		cidrs, cidrAndPorts, err := ResolveServices(context.Background(), c, []v1alpha1.ServiceTarget{
+			{Namespace: "default", Name: "web", Port: &servicePort},
+		})
+
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(cidrs).Should(BeEmpty())
+		g.Expect(cidrAndPorts).Should(Equal([]v1alpha1.CidrAndPort{
+			{Cidr: "10.96.0.10/32", Port: 80},
+			{Cidr: "10.244.0.2/32", Port: 8080},
+		}))
+	})
+
+	t.Run("headless service", func(t *testing.T) {
+		cidrs, _, err := ResolveServices(context.Background(), c, []v1alpha1.ServiceTarget{
+			{Namespace: "default", Name: "headless"},
+		})
+
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(cidrs).Should(Equal([]string{"10.244.0.4/32"}))
+	})
+
+	t.Run("service not found", func(t *testing.T) {
+		cidrs, cidrAndPorts, err := ResolveServices(context.Background(), c, []v1alpha1.ServiceTarget{
+			{Namespace: "default", Name: "not-exist"},
+		})
+
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(cidrs).Should(BeEmpty())
+		g.Expect(cidrAndPorts).Should(BeEmpty())
+	})
+
+	t.Run("port not found", func(t *testing.T) {
+		_, _, err := ResolveServices(context.Background(), c, []v1alpha1.ServiceTarget{
+			{Namespace: "default", Name: "web", Port: &unknownPort},
+		})
+
+		g.Expect(err).Should(HaveOccurred())
+	})
+}
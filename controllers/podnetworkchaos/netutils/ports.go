@@ -0,0 +1,62 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package netutils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// PortFilter represents the protocol and ports used to filter the packets on a pod
+type PortFilter struct {
+	Protocol         string
+	SourcePorts      string
+	DestinationPorts string
+}
+
+// NewPortFilter builds the port filter for the packets sent by the selected pods (egress)
+// or received by them (ingress). If the filter is built for the target pods, the
+// ports of both sides should be exchanged, which is controlled by onTarget.
+func NewPortFilter(spec *v1alpha1.NetworkChaosSpec, egress bool, onTarget bool) PortFilter {
+	// the local ports are the ports on the side of the pod where the filter is installed
+	localPorts, remotePorts := spec.SourcePorts, spec.Ports
+	if onTarget {
+		localPorts, remotePorts = remotePorts, localPorts
+	}
+
+	filter := PortFilter{
+		Protocol: spec.Protocol,
+	}
+	if egress {
+		filter.SourcePorts, filter.DestinationPorts = JoinPorts(localPorts), JoinPorts(remotePorts)
+	} else {
+		filter.SourcePorts, filter.DestinationPorts = JoinPorts(remotePorts), JoinPorts(localPorts)
+	}
+
+	return filter
+}
+
+// JoinPorts joins the ports in the form accepted by iptables, e.g. "80,443"
+func JoinPorts(ports []uint16) string {
+	parts := make([]string, 0, len(ports))
+	for _, port := range ports {
+		parts = append(parts, strconv.Itoa(int(port)))
+	}
+
+	return strings.Join(parts, ",")
+}
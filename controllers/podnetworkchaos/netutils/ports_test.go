@@ -0,0 +1,63 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package netutils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestJoinPorts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(JoinPorts(nil)).To(Equal(""))
+	g.Expect(JoinPorts([]uint16{5432})).To(Equal("5432"))
+	g.Expect(JoinPorts([]uint16{80, 443})).To(Equal("80,443"))
+}
+
+func TestNewPortFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := &v1alpha1.NetworkChaosSpec{
+		Protocol:    "tcp",
+		Ports:       []uint16{5432},
+		SourcePorts: []uint16{8080, 8081},
+	}
+
+	g.Expect(NewPortFilter(spec, true, false)).To(Equal(PortFilter{
+		Protocol:         "tcp",
+		SourcePorts:      "8080,8081",
+		DestinationPorts: "5432",
+	}))
+	g.Expect(NewPortFilter(spec, false, false)).To(Equal(PortFilter{
+		Protocol:         "tcp",
+		SourcePorts:      "5432",
+		DestinationPorts: "8080,8081",
+	}))
+	g.Expect(NewPortFilter(spec, true, true)).To(Equal(PortFilter{
+		Protocol:         "tcp",
+		SourcePorts:      "5432",
+		DestinationPorts: "8080,8081",
+	}))
+	g.Expect(NewPortFilter(spec, false, true)).To(Equal(PortFilter{
+		Protocol:         "tcp",
+		SourcePorts:      "8080,8081",
+		DestinationPorts: "5432",
+	}))
+}
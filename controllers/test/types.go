@@ -78,6 +78,18 @@ func (c *MockChaosDaemonClient) ContainerKill(ctx context.Context, in *chaosdaem
 	return nil, mockError("ContainerKill")
 }
 
+func (c *MockChaosDaemonClient) ContainerPause(ctx context.Context, in *chaosdaemon.ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("ContainerPause")
+}
+
+func (c *MockChaosDaemonClient) ContainerUnpause(ctx context.Context, in *chaosdaemon.ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("ContainerUnpause")
+}
+
+func (c *MockChaosDaemonClient) KillProcesses(ctx context.Context, in *chaosdaemon.KillProcessesRequest, opts ...grpc.CallOption) (*chaosdaemon.KillProcessesResponse, error) {
+	return nil, mockError("KillProcesses")
+}
+
 func (c *MockChaosDaemonClient) ApplyIOChaos(ctx context.Context, in *chaosdaemon.ApplyIOChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyIOChaosResponse, error) {
 	return nil, mockError("ApplyIOChaos")
 }
@@ -86,6 +98,10 @@ func (c *MockChaosDaemonClient) ApplyHttpChaos(ctx context.Context, in *chaosdae
 	return nil, mockError("ApplyHttpChaos")
 }
 
+func (c *MockChaosDaemonClient) ApplyGrpcChaos(ctx context.Context, in *chaosdaemon.ApplyGrpcChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyGrpcChaosResponse, error) {
+	return nil, mockError("ApplyGrpcChaos")
+}
+
 func (c *MockChaosDaemonClient) SetDNSServer(ctx context.Context, in *chaosdaemon.SetDNSServerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, mockError("SetDNSServer")
 }
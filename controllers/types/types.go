@@ -61,6 +61,14 @@ var ChaosObjects = fx.Supply(
 		},
 	},
 
+	fx.Annotated{
+		Group: "objs",
+		Target: Object{
+			Name:   "grpcchaos",
+			Object: &v1alpha1.GRPCChaos{},
+		},
+	},
+
 	fx.Annotated{
 		Group: "objs",
 		Target: Object{
@@ -176,4 +184,18 @@ var WebhookObjects = fx.Supply(
 			Object: &v1alpha1.StatusCheck{},
 		},
 	},
+	fx.Annotated{
+		Group: "webhookObjs",
+		Target: WebhookObject{
+			Name:   "workflowtemplate",
+			Object: &v1alpha1.WorkflowTemplate{},
+		},
+	},
+	fx.Annotated{
+		Group: "webhookObjs",
+		Target: WebhookObject{
+			Name:   "clusterworkflowtemplate",
+			Object: &v1alpha1.ClusterWorkflowTemplate{},
+		},
+	},
 )
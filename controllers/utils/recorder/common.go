@@ -51,6 +51,22 @@ func (r Recovered) Message() string {
 	return fmt.Sprintf("Successfully recover chaos for %s", r.Id)
 }
 
+type Resynced struct {
+	Id string
+}
+
+func (r Resynced) Type() string {
+	return "Normal"
+}
+
+func (r Resynced) Reason() string {
+	return "Resynced"
+}
+
+func (r Resynced) Message() string {
+	return fmt.Sprintf("Successfully re-sync chaos for %s", r.Id)
+}
+
 type NotSupported struct {
 	Activity string
 }
@@ -68,5 +84,5 @@ func (r NotSupported) Message() string {
 }
 
 func init() {
-	register(Applied{}, Recovered{}, NotSupported{})
+	register(Applied{}, Recovered{}, Resynced{}, NotSupported{})
 }
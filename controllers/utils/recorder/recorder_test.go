@@ -36,6 +36,7 @@ func TestGenerateAnnotations(t *testing.T) {
 		{map[string]string{"chaos-mesh.org/id": "", "chaos-mesh.org/type": "applied"}, Applied{}},
 		{map[string]string{"chaos-mesh.org/id": "test", "chaos-mesh.org/type": "applied"}, Applied{"test"}},
 		{map[string]string{"chaos-mesh.org/id": "test", "chaos-mesh.org/type": "recovered"}, Recovered{"test"}},
+		{map[string]string{"chaos-mesh.org/id": "test", "chaos-mesh.org/type": "resynced"}, Resynced{"test"}},
 
 		{map[string]string{"chaos-mesh.org/field": "test", "chaos-mesh.org/type": "updated"}, Updated{"test"}},
 
@@ -80,6 +81,7 @@ func TestParse(t *testing.T) {
 	testCases := []casePair{
 		{"Successfully apply chaos for test", Applied{"test"}},
 		{"Successfully recover chaos for test", Recovered{"test"}},
+		{"Successfully re-sync chaos for test", Resynced{"test"}},
 
 		{"Successfully update test of resource", Updated{"test"}},
 
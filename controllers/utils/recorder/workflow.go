@@ -40,6 +40,22 @@ func (it InvalidEntry) Message() string {
 	return fmt.Sprintf("failed to spawn new entry node of workflow, entry: %s", it.EntryTemplate)
 }
 
+type InvalidTemplateRef struct {
+	Cause string
+}
+
+func (it InvalidTemplateRef) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it InvalidTemplateRef) Reason() string {
+	return v1alpha1.InvalidTemplateRef
+}
+
+func (it InvalidTemplateRef) Message() string {
+	return fmt.Sprintf("failed to resolve the template references of workflow, cause: %s", it.Cause)
+}
+
 type EntryCreated struct {
 	Entry string
 }
@@ -358,6 +374,106 @@ func (it ParentNodeAborted) Message() string {
 	return fmt.Sprintf("abort the node because parent node %s aborted", it.ParentNodeName)
 }
 
+type TaskPodFailed struct {
+	PodName string
+}
+
+func (it TaskPodFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it TaskPodFailed) Reason() string {
+	return v1alpha1.TaskPodFailed
+}
+
+func (it TaskPodFailed) Message() string {
+	return fmt.Sprintf("task pod %s failed", it.PodName)
+}
+
+type ChaosInjectFailed struct {
+	Name  string
+	Kind  string
+	Cause string
+}
+
+func (it ChaosInjectFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it ChaosInjectFailed) Reason() string {
+	return v1alpha1.ChaosInjectFailed
+}
+
+func (it ChaosInjectFailed) Message() string {
+	return fmt.Sprintf("failed to inject chaos CR, kind: %s, name: %s, cause: %s", it.Kind, it.Name, it.Cause)
+}
+
+type ChildNodeFailed struct {
+	ChildNodeName string
+}
+
+func (it ChildNodeFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it ChildNodeFailed) Reason() string {
+	return v1alpha1.ChildNodeFailed
+}
+
+func (it ChildNodeFailed) Message() string {
+	return fmt.Sprintf("child node %s failed", it.ChildNodeName)
+}
+
+type LoopUntilEvaluationFailed struct {
+	Until string
+	Cause string
+}
+
+func (it LoopUntilEvaluationFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it LoopUntilEvaluationFailed) Reason() string {
+	return v1alpha1.LoopUntilEvaluationFailed
+}
+
+func (it LoopUntilEvaluationFailed) Message() string {
+	return fmt.Sprintf("failed to evaluate the until expression %s, cause: %s", it.Until, it.Cause)
+}
+
+type RetryScheduled struct {
+	Retries int
+	Backoff string
+}
+
+func (it RetryScheduled) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it RetryScheduled) Reason() string {
+	return v1alpha1.RetryScheduled
+}
+
+func (it RetryScheduled) Message() string {
+	return fmt.Sprintf("retry %d scheduled after %s", it.Retries, it.Backoff)
+}
+
+type NodeFailed struct {
+	Cause string
+}
+
+func (it NodeFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it NodeFailed) Reason() string {
+	return v1alpha1.NodeFailed
+}
+
+func (it NodeFailed) Message() string {
+	return fmt.Sprintf("node failed with no more retries, cause: %s", it.Cause)
+}
+
 type WorkflowAborted struct {
 	WorkflowName string
 }
@@ -377,6 +493,7 @@ func (it WorkflowAborted) Message() string {
 func init() {
 	register(
 		InvalidEntry{},
+		InvalidTemplateRef{},
 		EntryCreated{},
 		NodesCreated{},
 		ChaosCustomResourceCreated{},
@@ -397,5 +514,11 @@ func init() {
 		StatusCheckDeleted{},
 		StatusCheckDeletedFailed{},
 		ParentNodeAborted{},
+		TaskPodFailed{},
+		ChaosInjectFailed{},
+		ChildNodeFailed{},
+		RetryScheduled{},
+		NodeFailed{},
+		LoopUntilEvaluationFailed{},
 	)
 }
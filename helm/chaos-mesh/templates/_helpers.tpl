@@ -183,6 +183,8 @@ Define the webhook's name
   /run/containerd
   {{- else if eq .Values.chaosDaemon.runtime "crio" -}}
   /var/run/crio
+  {{- else if eq .Values.chaosDaemon.runtime "podman" -}}
+  /run/podman
   {{- end -}}
 {{- end -}}
 {{- end -}}
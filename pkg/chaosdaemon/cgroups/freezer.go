@@ -0,0 +1,126 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+)
+
+// Freezer could freeze and thaw all the processes in a cgroup
+type Freezer interface {
+	Freeze() error
+	Thaw() error
+}
+
+var _ Freezer = (*FreezerV1)(nil)
+
+type FreezerV1 struct {
+	path cgroups.Path
+}
+
+func (f *FreezerV1) load() (cgroups.Cgroup, error) {
+	cgroupv1, err := cgroups.Load(V1, f.path)
+	if err != nil {
+		freezerCGroupPath, _ := f.path(cgroups.Freezer)
+		return nil, errors.Wrapf(err, "load cgroup v1 manager, freezer path %s", freezerCGroupPath)
+	}
+	return cgroupv1, nil
+}
+
+func (f *FreezerV1) Freeze() error {
+	cgroupv1, err := f.load()
+	if err != nil {
+		return err
+	}
+	if err := cgroupv1.Freeze(); err != nil {
+		freezerCGroupPath, _ := f.path(cgroups.Freezer)
+		return errors.Wrapf(err, "freeze cgroup, freezer path %s", freezerCGroupPath)
+	}
+	return nil
+}
+
+func (f *FreezerV1) Thaw() error {
+	cgroupv1, err := f.load()
+	if err != nil {
+		return err
+	}
+	if err := cgroupv1.Thaw(); err != nil {
+		freezerCGroupPath, _ := f.path(cgroups.Freezer)
+		return errors.Wrapf(err, "thaw cgroup, freezer path %s", freezerCGroupPath)
+	}
+	return nil
+}
+
+var _ Freezer = (*FreezerV2)(nil)
+
+type FreezerV2 struct {
+	path string
+}
+
+func (f *FreezerV2) Freeze() error {
+	return f.setFrozen(true)
+}
+
+func (f *FreezerV2) Thaw() error {
+	return f.setFrozen(false)
+}
+
+func (f *FreezerV2) setFrozen(frozen bool) error {
+	value := 0
+	if frozen {
+		value = 1
+	}
+
+	// escape the CGroup Namespace like AttachCGroupV2
+	targetFile := f.freezeFile()
+	command := exec.Command("nsenter", "-C", "-t", "1", "--", "sh", "-c", fmt.Sprintf("echo %d > %s", value, targetFile))
+	output, err := command.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "set cgroup.freeze to %d, target cgroup file %s, output %s", value, targetFile, string(output))
+	}
+	return nil
+}
+
+func (f *FreezerV2) freezeFile() string {
+	return fmt.Sprintf("/host-sys/fs/cgroup%s/cgroup.freeze", f.path)
+}
+
+// GetFreezerForPID return a Freezer, which could freeze and thaw the cgroup of the target pid
+func GetFreezerForPID(targetPID int) (Freezer, error) {
+	return getFreezer(cgroups.Mode(), targetPID, V2PidGroupPath)
+}
+
+// getFreezer selects the Freezer by the cgroup mode, the v2GroupPath resolves the cgroup v2 path of the pid
+func getFreezer(mode cgroups.CGMode, targetPID int, v2GroupPath func(pid int) (string, error)) (Freezer, error) {
+	if mode == cgroups.Unified {
+		groupPath, err := v2GroupPath(targetPID)
+		if err != nil {
+			return nil, err
+		}
+		return &FreezerV2{
+			path: groupPath,
+		}, nil
+	}
+
+	// By default it's cgroup v1
+	return &FreezerV1{
+		path: PidPath(targetPID),
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/containerd/cgroups"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func Test_getFreezer(t *testing.T) {
+	g := NewWithT(t)
+
+	pid := os.Getpid()
+	v2GroupPath := func(targetPID int) (string, error) {
+		g.Expect(targetPID).To(Equal(pid))
+		return "/kubepods/burstable/pod1/container1", nil
+	}
+	v2GroupPathError := func(targetPID int) (string, error) {
+		return "", errors.New("cgroup path not found")
+	}
+
+	t.Run("cgroup v2", func(t *testing.T) {
+		freezer, err := getFreezer(cgroups.Unified, pid, v2GroupPath)
+		g.Expect(err).To(BeNil())
+		g.Expect(freezer).To(BeAssignableToTypeOf(&FreezerV2{}))
+		g.Expect(freezer.(*FreezerV2).freezeFile()).To(Equal("/host-sys/fs/cgroup/kubepods/burstable/pod1/container1/cgroup.freeze"))
+	})
+
+	t.Run("cgroup v2 path not found", func(t *testing.T) {
+		_, err := getFreezer(cgroups.Unified, pid, v2GroupPathError)
+		g.Expect(err).To(MatchError("cgroup path not found"))
+	})
+
+	for name, mode := range map[string]cgroups.CGMode{"legacy": cgroups.Legacy, "hybrid": cgroups.Hybrid} {
+		t.Run(fmt.Sprintf("cgroup v1 in %s mode", name), func(t *testing.T) {
+			freezer, err := getFreezer(mode, pid, v2GroupPathError)
+			g.Expect(err).To(BeNil())
+			g.Expect(freezer).To(BeAssignableToTypeOf(&FreezerV1{}))
+
+			paths, err := cgroups.ParseCgroupFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+			g.Expect(err).To(BeNil())
+			expected, ok := paths[string(cgroups.Freezer)]
+			if !ok {
+				t.Skip("freezer controller is not available")
+			}
+			path, err := freezer.(*FreezerV1).path(cgroups.Freezer)
+			g.Expect(err).To(BeNil())
+			g.Expect(path).To(Equal(expected))
+		})
+	}
+
+	t.Run("cgroup v1 process not found", func(t *testing.T) {
+		freezer, err := getFreezer(cgroups.Legacy, math.MaxInt32, v2GroupPathError)
+		g.Expect(err).To(BeNil())
+		err = freezer.Freeze()
+		g.Expect(err).NotTo(BeNil())
+		g.Expect(err.Error()).To(ContainSubstring("load cgroup v1 manager"))
+		err = freezer.Thaw()
+		g.Expect(err).NotTo(BeNil())
+		g.Expect(err.Error()).To(ContainSubstring("load cgroup v1 manager"))
+	})
+}
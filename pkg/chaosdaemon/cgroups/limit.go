@@ -0,0 +1,130 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+)
+
+// unlimitedMemoryV1 is the threshold of memory.limit_in_bytes, the cgroup v1 reports a huge
+// page-aligned value instead of "max" when the memory is not limited
+const unlimitedMemoryV1 = 1 << 62
+
+// GetMemoryLimitForPID returns the memory limit in bytes of the cgroup of the target pid,
+// it returns 0 if the memory is not limited
+func GetMemoryLimitForPID(targetPID int) (int64, error) {
+	var limitFile string
+	if cgroups.Mode() == cgroups.Unified {
+		groupPath, err := V2PidGroupPath(targetPID)
+		if err != nil {
+			return 0, err
+		}
+		limitFile = fmt.Sprintf("/host-sys/fs/cgroup%s/memory.max", groupPath)
+	} else {
+		groupPath, err := PidPath(targetPID)(cgroups.Memory)
+		if err != nil {
+			return 0, err
+		}
+		limitFile = fmt.Sprintf("/host-sys/fs/cgroup/memory%s/memory.limit_in_bytes", groupPath)
+	}
+
+	content, err := os.ReadFile(limitFile)
+	if err != nil {
+		return 0, errors.Wrapf(err, "read memory limit of pid %d", targetPID)
+	}
+	return parseMemoryLimit(string(content))
+}
+
+func parseMemoryLimit(content string) (int64, error) {
+	content = strings.TrimSpace(content)
+	if content == "max" {
+		return 0, nil
+	}
+
+	limit, err := strconv.ParseInt(content, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse memory limit %s", content)
+	}
+	if limit >= unlimitedMemoryV1 {
+		return 0, nil
+	}
+	return limit, nil
+}
+
+// GetCPUQuotaForPID returns the cpu quota in cores of the cgroup of the target pid,
+// it returns 0 if the cpu is not limited
+func GetCPUQuotaForPID(targetPID int) (float64, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		groupPath, err := V2PidGroupPath(targetPID)
+		if err != nil {
+			return 0, err
+		}
+		content, err := os.ReadFile(fmt.Sprintf("/host-sys/fs/cgroup%s/cpu.max", groupPath))
+		if err != nil {
+			return 0, errors.Wrapf(err, "read cpu quota of pid %d", targetPID)
+		}
+
+		// the content is like "max 100000" or "200000 100000"
+		fields := strings.Fields(string(content))
+		if len(fields) != 2 {
+			return 0, errors.Errorf("invalid cpu.max %s", string(content))
+		}
+		if fields[0] == "max" {
+			return 0, nil
+		}
+		return parseCPUQuota(fields[0], fields[1])
+	}
+
+	groupPath, err := PidPath(targetPID)(cgroups.Cpu)
+	if err != nil {
+		return 0, err
+	}
+	quota, err := os.ReadFile(fmt.Sprintf("/host-sys/fs/cgroup/cpu%s/cpu.cfs_quota_us", groupPath))
+	if err != nil {
+		return 0, errors.Wrapf(err, "read cpu quota of pid %d", targetPID)
+	}
+	period, err := os.ReadFile(fmt.Sprintf("/host-sys/fs/cgroup/cpu%s/cpu.cfs_period_us", groupPath))
+	if err != nil {
+		return 0, errors.Wrapf(err, "read cpu period of pid %d", targetPID)
+	}
+	return parseCPUQuota(string(quota), string(period))
+}
+
+func parseCPUQuota(quota string, period string) (float64, error) {
+	quotaUs, err := strconv.ParseInt(strings.TrimSpace(quota), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse cpu quota %s", quota)
+	}
+	// cgroup v1 reports -1 when the cpu is not limited
+	if quotaUs <= 0 {
+		return 0, nil
+	}
+
+	periodUs, err := strconv.ParseInt(strings.TrimSpace(period), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse cpu period %s", period)
+	}
+	if periodUs <= 0 {
+		return 0, errors.Errorf("invalid cpu period %d", periodUs)
+	}
+	return float64(quotaUs) / float64(periodUs), nil
+}
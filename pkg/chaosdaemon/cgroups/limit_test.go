@@ -0,0 +1,80 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_parseMemoryLimit(t *testing.T) {
+	g := NewWithT(t)
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected int64
+		wantErr  bool
+	}{
+		{"cgroup v2 limited", "536870912\n", 536870912, false},
+		{"cgroup v2 unlimited", "max\n", 0, false},
+		{"cgroup v1 limited", "1073741824\n", 1073741824, false},
+		{"cgroup v1 unlimited", "9223372036854771712\n", 0, false},
+		{"invalid", "foo\n", 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limit, err := parseMemoryLimit(tc.content)
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(limit).To(Equal(tc.expected))
+		})
+	}
+}
+
+func Test_parseCPUQuota(t *testing.T) {
+	g := NewWithT(t)
+
+	testCases := []struct {
+		name     string
+		quota    string
+		period   string
+		expected float64
+		wantErr  bool
+	}{
+		{"two cores", "200000\n", "100000\n", 2, false},
+		{"half a core", "50000", "100000", 0.5, false},
+		{"cgroup v1 unlimited", "-1\n", "100000\n", 0, false},
+		{"invalid quota", "max", "100000", 0, true},
+		{"invalid period", "200000", "foo", 0, true},
+		{"zero period", "200000", "0", 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			quota, err := parseCPUQuota(tc.quota, tc.period)
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(quota).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// ContainerPause freezes all processes of the container according to container id in the req
+func (s *DaemonServer) ContainerPause(ctx context.Context, req *pb.ContainerRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("Container Pause", "request", req)
+
+	action := req.Action.Action
+	if action != pb.ContainerAction_PAUSE {
+		err := errors.Errorf("container action is %s , not pause", action)
+		log.Error(err, "container action is not expected")
+		return nil, err
+	}
+
+	freezer, err := s.getFreezer(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting freezer of container")
+		return nil, err
+	}
+
+	if err := freezer.Freeze(); err != nil {
+		log.Error(err, "error while freezing container")
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ContainerUnpause thaws all processes of the container according to container id in the req
+func (s *DaemonServer) ContainerUnpause(ctx context.Context, req *pb.ContainerRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("Container Unpause", "request", req)
+
+	action := req.Action.Action
+	if action != pb.ContainerAction_UNPAUSE {
+		err := errors.Errorf("container action is %s , not unpause", action)
+		log.Error(err, "container action is not expected")
+		return nil, err
+	}
+
+	freezer, err := s.getFreezer(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting freezer of container")
+		return nil, err
+	}
+
+	if err := freezer.Thaw(); err != nil {
+		log.Error(err, "error while thawing container")
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *DaemonServer) getFreezer(ctx context.Context, containerID string) (cgroups.Freezer, error) {
+	pid, err := s.crClient.GetPidFromContainerID(ctx, containerID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get pid of container %s", containerID)
+	}
+
+	return cgroups.GetFreezerForPID(int(pid))
+}
@@ -21,24 +21,30 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/containerd"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/cri"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/crio"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/docker"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/podman"
 )
 
 const (
 	ContainerRuntimeDocker     = "docker"
 	ContainerRuntimeContainerd = "containerd"
 	ContainerRuntimeCrio       = "crio"
+	ContainerRuntimePodman     = "podman"
+	// ContainerRuntimeCRI represents any container runtime which implements CRI
+	ContainerRuntimeCRI = "cri"
 
 	defaultDockerSocket     = "unix:///var/run/docker.sock"
 	defaultContainerdSocket = "/run/containerd/containerd.sock"
 	defaultCrioSocket       = "/var/run/crio/crio.sock"
+	defaultPodmanSocket     = "/run/podman/podman.sock"
 	containerdDefaultNS     = "k8s.io"
 )
 
 // CrClientConfig contains the basic cr client configuration.
 type CrClientConfig struct {
-	// Support docker, containerd, crio for now
+	// Support docker, containerd, crio, podman and any CRI implementation for now
 	Runtime      string
 	SocketPath   string
 	ContainerdNS string
@@ -55,8 +61,6 @@ type ContainerRuntimeInfoClient interface {
 
 // CreateContainerRuntimeInfoClient creates a container runtime information client.
 func CreateContainerRuntimeInfoClient(clientConfig *CrClientConfig) (ContainerRuntimeInfoClient, error) {
-	// TODO: support more container runtime
-
 	var cli ContainerRuntimeInfoClient
 	var err error
 	socketPath := clientConfig.SocketPath
@@ -92,8 +96,25 @@ func CreateContainerRuntimeInfoClient(clientConfig *CrClientConfig) (ContainerRu
 		if err != nil {
 			return nil, err
 		}
+	case ContainerRuntimePodman:
+		if socketPath == "" {
+			socketPath = defaultPodmanSocket
+		}
+		cli, err = podman.New(socketPath)
+		if err != nil {
+			return nil, err
+		}
+	case ContainerRuntimeCRI:
+		// there is no default socket for a generic CRI implementation
+		if socketPath == "" {
+			return nil, errors.New("socket path is required for the generic CRI runtime")
+		}
+		cli, err = cri.New(socketPath)
+		if err != nil {
+			return nil, err
+		}
 	default:
-		return nil, errors.Errorf("only docker/containerd/crio is supported, but got %s", clientConfig.Runtime)
+		return nil, errors.Errorf("only docker/containerd/crio/podman/cri is supported, but got %s", clientConfig.Runtime)
 	}
 
 	return cli, nil
@@ -70,6 +70,27 @@ var _ = Describe("chaosdaemon util", func() {
 			Expect(err).To(BeNil())
 		})
 
+		It("should work for podman", func() {
+			_, err := CreateContainerRuntimeInfoClient(&CrClientConfig{Runtime: ContainerRuntimePodman})
+			Expect(err).To(BeNil())
+			_, err = CreateContainerRuntimeInfoClient(&CrClientConfig{
+				Runtime:    ContainerRuntimePodman,
+				SocketPath: "/foo/bar/podman.sock"})
+			Expect(err).To(BeNil())
+		})
+
+		It("should error for cri without socket path", func() {
+			_, err := CreateContainerRuntimeInfoClient(&CrClientConfig{Runtime: ContainerRuntimeCRI})
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("socket path is required"))
+		})
+
+		It("should error on unknown runtime", func() {
+			_, err := CreateContainerRuntimeInfoClient(&CrClientConfig{Runtime: "rkt"})
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("but got rkt"))
+		})
+
 		It("should error on newContaineredClient", func() {
 			errorStr := "this is a mocked error"
 
@@ -0,0 +1,167 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cri
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const (
+	protocolSeparator = "://"
+
+	// containerInfoKey is the key of the verbose information in the container status,
+	// which contains the pid of the container in most CRI implementations
+	containerInfoKey = "info"
+
+	connectionTimeout = 32 * time.Second
+)
+
+// CRIClient can get information from any container runtime which implements CRI
+type CRIClient struct {
+	runtimeClient v1.RuntimeServiceClient
+
+	// protocolPrefix is the prefix of container IDs reported by kubelet,
+	// which is the runtime name of the CRI implementation
+	protocolPrefix string
+}
+
+// FormatContainerID strips protocol prefix from the container ID
+func (c CRIClient) FormatContainerID(ctx context.Context, containerID string) (string, error) {
+	if len(containerID) < len(c.protocolPrefix) {
+		return "", errors.Errorf("container id %s is not a %s container id", containerID, c.protocolPrefix)
+	}
+	if containerID[0:len(c.protocolPrefix)] != c.protocolPrefix {
+		return "", errors.Errorf("expected %s but got %s", c.protocolPrefix, containerID[0:len(c.protocolPrefix)])
+	}
+	return containerID[len(c.protocolPrefix):], nil
+}
+
+// GetPidFromContainerID fetches PID according to container id
+func (c CRIClient) GetPidFromContainerID(ctx context.Context, containerID string) (uint32, error) {
+	id, err := c.FormatContainerID(ctx, containerID)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.runtimeClient.ContainerStatus(ctx, &v1.ContainerStatusRequest{
+		ContainerId: id,
+		Verbose:     true,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	info, ok := resp.Info[containerInfoKey]
+	if !ok {
+		return 0, errors.Errorf("container %s has no verbose information", id)
+	}
+
+	var containerInfo struct {
+		Pid uint32 `json:"pid"`
+	}
+	if err := json.Unmarshal([]byte(info), &containerInfo); err != nil {
+		return 0, errors.Wrapf(err, "parse verbose information of container %s", id)
+	}
+	if containerInfo.Pid == 0 {
+		return 0, errors.Errorf("container is not running, state: %s", resp.GetStatus().GetState())
+	}
+
+	return containerInfo.Pid, nil
+}
+
+// ContainerKillByContainerID kills container according to container id
+func (c CRIClient) ContainerKillByContainerID(ctx context.Context, containerID string) error {
+	pid, err := c.GetPidFromContainerID(ctx, containerID)
+	if err != nil {
+		return err
+	}
+	return syscall.Kill(int(pid), syscall.SIGKILL)
+}
+
+// ListContainerIDs lists all container IDs
+func (c CRIClient) ListContainerIDs(ctx context.Context) ([]string, error) {
+	resp, err := c.runtimeClient.ListContainers(ctx, &v1.ListContainersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, container := range resp.Containers {
+		id := fmt.Sprintf("%s%s", c.protocolPrefix, container.Id)
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// GetLabelsFromContainerID returns the labels according to container ID
+func (c CRIClient) GetLabelsFromContainerID(ctx context.Context, containerID string) (map[string]string, error) {
+	id, err := c.FormatContainerID(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.runtimeClient.ContainerStatus(ctx, &v1.ContainerStatusRequest{
+		ContainerId: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetStatus().GetLabels(), nil
+}
+
+// New creates a CRIClient connecting to the CRI socket. The runtime name reported by
+// the CRI implementation is used as the protocol prefix of container IDs, which is the
+// same as the one used by kubelet.
+func New(socketPath string) (*CRIClient, error) {
+	endpoint := socketPath
+	if !strings.Contains(endpoint, protocolSeparator) {
+		endpoint = "unix://" + endpoint
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), connectionTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, endpoint, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, errors.Wrapf(err, "connect to CRI endpoint %s", endpoint)
+	}
+
+	runtimeClient := v1.NewRuntimeServiceClient(conn)
+	version, err := runtimeClient.Version(ctx, &v1.VersionRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "get version of CRI endpoint %s", endpoint)
+	}
+	if version.RuntimeName == "" {
+		conn.Close()
+		return nil, errors.Errorf("CRI endpoint %s reports an empty runtime name", endpoint)
+	}
+
+	return &CRIClient{
+		runtimeClient:  runtimeClient,
+		protocolPrefix: version.RuntimeName + protocolSeparator,
+	}, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cri
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/envtest/printer"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/test"
+)
+
+func TestCRIClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"CRI Container Client Test Suit",
+		[]Reporter{printer.NewlineReporter{}})
+}
+
+var _ = Describe("cri client", func() {
+	sampleLabels := map[string]string{
+		"io.kubernetes.pod.namespace":  "default",
+		"io.kubernetes.pod.name":       "busybox-5f8dd756dd-6rjzw",
+		"io.kubernetes.container.name": "busybox",
+	}
+
+	var (
+		dir     string
+		service *test.FakeRuntimeService
+		stop    func()
+		c       *CRIClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "chaos-mesh-cri")
+		Expect(err).To(BeNil())
+
+		service = &test.FakeRuntimeService{
+			RuntimeName: "fakeruntime",
+			Containers: map[string]test.FakeContainer{
+				"valid-container-id":   {Pid: 9527, Labels: sampleLabels},
+				"stopped-container-id": {},
+			},
+		}
+		socketPath := filepath.Join(dir, "cri.sock")
+		stop, err = test.StartFakeRuntimeService(socketPath, service)
+		Expect(err).To(BeNil())
+
+		c, err = New(socketPath)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		stop()
+		os.RemoveAll(dir)
+	})
+
+	Context("New", func() {
+		It("should use the runtime name as protocol prefix", func() {
+			Expect(c.protocolPrefix).To(Equal("fakeruntime://"))
+		})
+
+		It("should error on empty runtime name", func() {
+			socketPath := filepath.Join(dir, "unnamed.sock")
+			stop, err := test.StartFakeRuntimeService(socketPath, &test.FakeRuntimeService{})
+			Expect(err).To(BeNil())
+			defer stop()
+
+			_, err = New("unix://" + socketPath)
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("empty runtime name"))
+		})
+	})
+
+	Context("CRIClient GetPidFromContainerID", func() {
+		It("should return the magic number 9527", func() {
+			pid, err := c.GetPidFromContainerID(context.TODO(), "fakeruntime://valid-container-id")
+			Expect(err).To(BeNil())
+			Expect(pid).To(Equal(uint32(9527)))
+		})
+
+		It("should error with wrong protocol", func() {
+			_, err := c.GetPidFromContainerID(context.TODO(), "containerd://this-is-a-wrong-protocol")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("expected fakeruntime:// but got"))
+		})
+
+		It("should error on stopped container", func() {
+			_, err := c.GetPidFromContainerID(context.TODO(), "fakeruntime://stopped-container-id")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("container is not running"))
+		})
+
+		It("should error on container not found", func() {
+			_, err := c.GetPidFromContainerID(context.TODO(), "fakeruntime://not-found-container-id")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("not found"))
+		})
+	})
+
+	Context("CRIClient ContainerKillByContainerID", func() {
+		It("should kill the process of container", func() {
+			cmd := exec.Command("sleep", "60")
+			Expect(cmd.Start()).To(BeNil())
+			service.Containers["sleep-container-id"] = test.FakeContainer{Pid: uint32(cmd.Process.Pid)}
+
+			err := c.ContainerKillByContainerID(context.TODO(), "fakeruntime://sleep-container-id")
+			Expect(err).To(BeNil())
+
+			err = cmd.Wait()
+			Expect(err).NotTo(BeNil())
+			waitStatus := cmd.ProcessState.Sys().(syscall.WaitStatus)
+			Expect(waitStatus.Signal()).To(Equal(syscall.SIGKILL))
+		})
+
+		It("should error on container not found", func() {
+			err := c.ContainerKillByContainerID(context.TODO(), "fakeruntime://not-found-container-id")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("CRIClient ListContainerIDs", func() {
+		It("should return the container ids with protocol prefix", func() {
+			ids, err := c.ListContainerIDs(context.TODO())
+			Expect(err).To(BeNil())
+			Expect(ids).To(Equal([]string{"fakeruntime://stopped-container-id", "fakeruntime://valid-container-id"}))
+		})
+	})
+
+	Context("CRIClient GetLabelsFromContainerID", func() {
+		It("should return the labels", func() {
+			labels, err := c.GetLabelsFromContainerID(context.TODO(), "fakeruntime://valid-container-id")
+			Expect(err).To(BeNil())
+			Expect(labels).To(Equal(sampleLabels))
+		})
+
+		It("should error on short protocol", func() {
+			_, err := c.GetLabelsFromContainerID(context.TODO(), "fake:")
+			Expect(err).ToNot(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("is not a fakeruntime:// container id"))
+		})
+	})
+})
@@ -0,0 +1,196 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// libpodAPIPrefix is the prefix of the libpod REST API, which is supported since podman v3
+	libpodAPIPrefix = "/v3.0.0/libpod"
+
+	podmanProtocolPrefix  = "podman://"
+	maxUnixSocketPathSize = len(syscall.RawSockaddrUnix{}.Path)
+)
+
+// PodmanClient can get information from podman
+type PodmanClient struct {
+	client     *http.Client
+	socketPath string
+}
+
+// containerInspect is a subset of the response of inspecting a container in the libpod API
+type containerInspect struct {
+	State struct {
+		Status string `json:"Status"`
+		Pid    int    `json:"Pid"`
+	} `json:"State"`
+	Config struct {
+		Labels map[string]string `json:"Labels"`
+	} `json:"Config"`
+}
+
+// containerListItem is a subset of the response of listing containers in the libpod API
+type containerListItem struct {
+	ID string `json:"Id"`
+}
+
+// FormatContainerID strips protocol prefix from the container ID
+func (c PodmanClient) FormatContainerID(ctx context.Context, containerID string) (string, error) {
+	if len(containerID) < len(podmanProtocolPrefix) {
+		return "", errors.Errorf("container id %s is not a podman container id", containerID)
+	}
+	if containerID[0:len(podmanProtocolPrefix)] != podmanProtocolPrefix {
+		return "", errors.Errorf("expected %s but got %s", podmanProtocolPrefix, containerID[0:len(podmanProtocolPrefix)])
+	}
+	return containerID[len(podmanProtocolPrefix):], nil
+}
+
+// GetPidFromContainerID fetches PID according to container id
+func (c PodmanClient) GetPidFromContainerID(ctx context.Context, containerID string) (uint32, error) {
+	container, err := c.inspect(ctx, containerID)
+	if err != nil {
+		return 0, err
+	}
+
+	if container.State.Pid == 0 {
+		return 0, errors.Errorf("container is not running, status: %s", container.State.Status)
+	}
+
+	return uint32(container.State.Pid), nil
+}
+
+// ContainerKillByContainerID kills container according to container id
+func (c PodmanClient) ContainerKillByContainerID(ctx context.Context, containerID string) error {
+	id, err := c.FormatContainerID(ctx, containerID)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("signal", "SIGKILL")
+	return c.do(ctx, http.MethodPost, "/containers/"+url.PathEscape(id)+"/kill", query, nil)
+}
+
+// ListContainerIDs lists all container IDs
+func (c PodmanClient) ListContainerIDs(ctx context.Context) ([]string, error) {
+	var containers []containerListItem
+	err := c.do(ctx, http.MethodGet, "/containers/json", nil, &containers)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, container := range containers {
+		id := fmt.Sprintf("%s%s", podmanProtocolPrefix, container.ID)
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// GetLabelsFromContainerID returns the labels according to container ID
+func (c PodmanClient) GetLabelsFromContainerID(ctx context.Context, containerID string) (map[string]string, error) {
+	container, err := c.inspect(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return container.Config.Labels, nil
+}
+
+func (c PodmanClient) inspect(ctx context.Context, containerID string) (*containerInspect, error) {
+	id, err := c.FormatContainerID(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	container := &containerInspect{}
+	err = c.do(ctx, http.MethodGet, "/containers/"+url.PathEscape(id)+"/json", nil, container)
+	if err != nil {
+		return nil, err
+	}
+	return container, nil
+}
+
+// do sends a request to the libpod API, and decodes the response into out if it's not nil
+func (c PodmanClient) do(ctx context.Context, method string, path string, query url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, libpodAPIPrefix+path, nil)
+	if err != nil {
+		return err
+	}
+	// For local communications over a unix socket, it doesn't matter what
+	// the host is. We just need a valid and meaningful host name.
+	req.Host = "podman"
+	req.URL.Host = "podman"
+	req.URL.Scheme = "http"
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		// the libpod API returns the error as {"cause": "...", "message": "...", "response": 404}
+		var apiError struct {
+			Message string `json:"message"`
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(body, &apiError) == nil && apiError.Message != "" {
+			return errors.Errorf("podman API %s %s: %s", method, path, apiError.Message)
+		}
+		return errors.Errorf("podman API %s %s: unexpected status code %d", method, path, resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func New(socketPath string) (*PodmanClient, error) {
+	if len(socketPath) > maxUnixSocketPathSize {
+		return nil, errors.Errorf("unix socket path %q is too long", socketPath)
+	}
+
+	tr := &http.Transport{
+		// No need for compression in local communications.
+		DisableCompression: true,
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			dialer := net.Dialer{Timeout: 32 * time.Second}
+			return dialer.DialContext(ctx, "unix", socketPath)
+		},
+	}
+
+	return &PodmanClient{
+		client: &http.Client{
+			Transport: tr,
+		},
+		socketPath: socketPath,
+	}, nil
+}
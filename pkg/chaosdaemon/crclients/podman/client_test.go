@@ -0,0 +1,163 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podman
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/envtest/printer"
+)
+
+func TestPodmanClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"Podman Container Client Test Suit",
+		[]Reporter{printer.NewlineReporter{}})
+}
+
+var _ = Describe("podman client", func() {
+	var (
+		dir    string
+		server *httptest.Server
+		killed []string
+		c      *PodmanClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "chaos-mesh-podman")
+		Expect(err).To(BeNil())
+
+		killed = nil
+		mux := http.NewServeMux()
+		mux.HandleFunc(libpodAPIPrefix+"/containers/json", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `[{"Id":"valid-container-id"},{"Id":"stopped-container-id"}]`)
+		})
+		mux.HandleFunc(libpodAPIPrefix+"/containers/valid-container-id/json", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"State":{"Status":"running","Pid":9527},"Config":{"Labels":{"io.kubernetes.pod.name":"busybox"}}}`)
+		})
+		mux.HandleFunc(libpodAPIPrefix+"/containers/stopped-container-id/json", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"State":{"Status":"exited","Pid":0},"Config":{"Labels":{}}}`)
+		})
+		mux.HandleFunc(libpodAPIPrefix+"/containers/valid-container-id/kill", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			killed = append(killed, r.URL.Query().Get("signal"))
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"cause":"no such container","message":"no container with name or ID found","response":404}`)
+		})
+
+		socketPath := filepath.Join(dir, "podman.sock")
+		listener, err := net.Listen("unix", socketPath)
+		Expect(err).To(BeNil())
+		server = &httptest.Server{
+			Listener: listener,
+			Config:   &http.Server{Handler: mux},
+		}
+		server.Start()
+
+		c, err = New(socketPath)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	Context("PodmanClient GetPidFromContainerID", func() {
+		It("should return the magic number 9527", func() {
+			pid, err := c.GetPidFromContainerID(context.TODO(), "podman://valid-container-id")
+			Expect(err).To(BeNil())
+			Expect(pid).To(Equal(uint32(9527)))
+		})
+
+		It("should error with wrong protocol", func() {
+			_, err := c.GetPidFromContainerID(context.TODO(), "docker://this-is-a-wrong-protocol")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("expected podman:// but got"))
+		})
+
+		It("should error with specified short protocol", func() {
+			_, err := c.GetPidFromContainerID(context.TODO(), "podman:")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("is not a podman container id"))
+		})
+
+		It("should error on stopped container", func() {
+			_, err := c.GetPidFromContainerID(context.TODO(), "podman://stopped-container-id")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("container is not running"))
+		})
+
+		It("should return the API error message", func() {
+			_, err := c.GetPidFromContainerID(context.TODO(), "podman://not-found-container-id")
+			Expect(err).NotTo(BeNil())
+			Expect(fmt.Sprintf("%s", err)).To(ContainSubstring("no container with name or ID found"))
+		})
+	})
+
+	Context("PodmanClient ContainerKillByContainerID", func() {
+		It("should send SIGKILL", func() {
+			err := c.ContainerKillByContainerID(context.TODO(), "podman://valid-container-id")
+			Expect(err).To(BeNil())
+			Expect(killed).To(Equal([]string{"SIGKILL"}))
+		})
+
+		It("should error on container not found", func() {
+			err := c.ContainerKillByContainerID(context.TODO(), "podman://not-found-container-id")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("PodmanClient ListContainerIDs", func() {
+		It("should return the container ids with protocol prefix", func() {
+			ids, err := c.ListContainerIDs(context.TODO())
+			Expect(err).To(BeNil())
+			Expect(ids).To(Equal([]string{"podman://valid-container-id", "podman://stopped-container-id"}))
+		})
+	})
+
+	Context("PodmanClient GetLabelsFromContainerID", func() {
+		It("should return the labels", func() {
+			labels, err := c.GetLabelsFromContainerID(context.TODO(), "podman://valid-container-id")
+			Expect(err).To(BeNil())
+			Expect(labels).To(Equal(map[string]string{"io.kubernetes.pod.name": "busybox"}))
+		})
+	})
+
+	Context("New", func() {
+		It("should error on too long socket path", func() {
+			_, err := New("/" + string(make([]byte, maxUnixSocketPathSize)))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,105 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// FakeContainer is a container served by the FakeRuntimeService
+type FakeContainer struct {
+	Pid    uint32
+	Labels map[string]string
+}
+
+// FakeRuntimeService is a CRI runtime service serving the containers in memory
+type FakeRuntimeService struct {
+	v1.UnimplementedRuntimeServiceServer
+
+	RuntimeName string
+	Containers  map[string]FakeContainer
+}
+
+func (s *FakeRuntimeService) Version(ctx context.Context, req *v1.VersionRequest) (*v1.VersionResponse, error) {
+	return &v1.VersionResponse{
+		Version:           "0.1.0",
+		RuntimeName:       s.RuntimeName,
+		RuntimeVersion:    "0.1.0",
+		RuntimeApiVersion: "v1",
+	}, nil
+}
+
+func (s *FakeRuntimeService) ListContainers(ctx context.Context, req *v1.ListContainersRequest) (*v1.ListContainersResponse, error) {
+	ids := []string{}
+	for id := range s.Containers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	containers := []*v1.Container{}
+	for _, id := range ids {
+		containers = append(containers, &v1.Container{
+			Id:     id,
+			Labels: s.Containers[id].Labels,
+		})
+	}
+	return &v1.ListContainersResponse{Containers: containers}, nil
+}
+
+func (s *FakeRuntimeService) ContainerStatus(ctx context.Context, req *v1.ContainerStatusRequest) (*v1.ContainerStatusResponse, error) {
+	container, ok := s.Containers[req.ContainerId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "container %s not found", req.ContainerId)
+	}
+
+	resp := &v1.ContainerStatusResponse{
+		Status: &v1.ContainerStatus{
+			Id:     req.ContainerId,
+			State:  v1.ContainerState_CONTAINER_RUNNING,
+			Labels: container.Labels,
+		},
+	}
+	if req.Verbose {
+		resp.Info = map[string]string{
+			"info": fmt.Sprintf(`{"pid":%d}`, container.Pid),
+		}
+	}
+	return resp, nil
+}
+
+// StartFakeRuntimeService serves the service on the unix socket, and returns a function to stop it
+func StartFakeRuntimeService(socketPath string, service *FakeRuntimeService) (func(), error) {
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer()
+	v1.RegisterRuntimeServiceServer(server, service)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	return server.Stop, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/grpcproxy"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+func (s *DaemonServer) ApplyGrpcChaos(ctx context.Context, in *pb.ApplyGrpcChaosRequest) (*pb.ApplyGrpcChaosResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("applying grpc chaos")
+
+	if in.InstanceUid == "" {
+		if uid, ok := s.backgroundProcessManager.GetUID(bpm.ProcessPair{Pid: int(in.Instance), CreateTime: in.StartTime}); ok {
+			in.InstanceUid = uid
+		}
+	}
+
+	if _, ok := s.backgroundProcessManager.GetPipes(in.InstanceUid); !ok {
+		if in.InstanceUid != "" {
+			// chaos daemon may restart, create another grpc proxy
+			if err := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); err != nil {
+				// ignore this error
+				log.Error(err, "kill background process", "uid", in.InstanceUid)
+			}
+		}
+
+		// set uid internally
+		if err := s.createGrpcChaos(ctx, in); err != nil {
+			return nil, errors.Wrap(err, "create grpc chaos")
+		}
+	}
+
+	resp, err := s.applyGrpcChaos(ctx, in)
+	if err != nil {
+		if killError := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); killError != nil {
+			log.Error(killError, "kill grpc proxy", "uid", in.InstanceUid)
+		}
+		return nil, errors.Wrap(err, "apply config")
+	}
+	return resp, err
+}
+
+func (s *DaemonServer) applyGrpcChaos(ctx context.Context, in *pb.ApplyGrpcChaosRequest) (*pb.ApplyGrpcChaosResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	pipes, ok := s.backgroundProcessManager.GetPipes(in.InstanceUid)
+	if !ok {
+		return nil, errors.Errorf("fail to get process(%s)", in.InstanceUid)
+	}
+
+	transport := &stdioTransport{
+		uid:    in.InstanceUid,
+		locker: s.tproxyLocker,
+		pipes:  pipes,
+	}
+
+	var rules []grpcproxy.Rule
+	err := json.Unmarshal([]byte(in.Rules), &rules)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal rules")
+	}
+
+	log.Info("the length of actions", "length", len(rules))
+
+	grpcChaosSpec := grpcproxy.Config{
+		ProxyPorts: in.ProxyPorts,
+		Rules:      rules,
+	}
+
+	if len(in.Tls) != 0 {
+		grpcChaosSpec.TLS = new(tproxyconfig.TLSConfig)
+		err = json.Unmarshal([]byte(in.Tls), grpcChaosSpec.TLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal tls config")
+		}
+	}
+
+	config, err := json.Marshal(&grpcChaosSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("ready to apply", "config", string(config))
+
+	req, err := http.NewRequest(http.MethodPut, "/", bytes.NewReader(config))
+	if err != nil {
+		return nil, errors.Wrap(err, "create http request")
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	log.Info("grpc chaos applied")
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	return &pb.ApplyGrpcChaosResponse{
+		Instance:    int64(in.Instance),
+		InstanceUid: in.InstanceUid,
+		StartTime:   in.StartTime,
+		StatusCode:  int32(resp.StatusCode),
+		Error:       string(body),
+	}, nil
+}
+
+func (s *DaemonServer) createGrpcChaos(ctx context.Context, in *pb.ApplyGrpcChaosRequest) error {
+	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
+	if err != nil {
+		return errors.Wrapf(err, "get PID of container(%s)", in.ContainerId)
+	}
+	// the grpc proxy only needs the network namespace of the container, as it redirects the connections with iptables
+	processBuilder := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, "grpc-proxy").
+		SetIdentifier(fmt.Sprintf("grpc-proxy-%s", in.ContainerId)).
+		SetEnv(pathEnv, os.Getenv(pathEnv))
+
+	if in.EnterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+	}
+
+	cmd := processBuilder.Build(ctx)
+	cmd.Stderr = os.Stderr
+
+	proc, err := s.backgroundProcessManager.StartProcess(ctx, cmd)
+	if err != nil {
+		return errors.Wrapf(err, "execute command(%s)", cmd)
+	}
+
+	in.Instance = int64(proc.Pair.Pid)
+	in.StartTime = proc.Pair.CreateTime
+	in.InstanceUid = proc.Uid
+	return nil
+}
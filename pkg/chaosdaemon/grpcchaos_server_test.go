@@ -0,0 +1,79 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// fakeGrpcProxyScript responds 200 to every config put by the chaos daemon
+const fakeGrpcProxyScript = `while IFS= read -r line; do
+	if [ -z "$(printf '%s' "$line" | tr -d '\r')" ]; then
+		printf 'HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n'
+	fi
+done`
+
+func TestApplyGrpcChaos(t *testing.T) {
+	g := NewWithT(t)
+
+	s := &DaemonServer{
+		backgroundProcessManager: bpm.StartBackgroundProcessManager(nil, logr.Discard()),
+		rootLogger:               logr.Discard(),
+		tproxyLocker:             new(sync.Map),
+	}
+	ctx := context.Background()
+
+	// the grpc proxy is started by a former apply
+	proc, err := s.backgroundProcessManager.StartProcess(ctx, bpm.DefaultProcessBuilder("sh", "-c", fakeGrpcProxyScript).Build(ctx))
+	g.Expect(err).To(BeNil())
+	defer s.backgroundProcessManager.KillBackgroundProcess(ctx, proc.Uid)
+
+	t.Run("apply to the running proxy", func(t *testing.T) {
+		resp, err := s.ApplyGrpcChaos(ctx, &pb.ApplyGrpcChaosRequest{
+			Rules:       `[{"target":"Request","selector":{"service":"helloworld.Greeter"},"actions":{"abort":{"code":14}},"port":50051}]`,
+			ProxyPorts:  []uint32{50051},
+			ContainerId: "container",
+			Instance:    int64(proc.Pair.Pid),
+			StartTime:   proc.Pair.CreateTime,
+		})
+		g.Expect(err).To(BeNil())
+		g.Expect(resp.StatusCode).To(Equal(int32(http.StatusOK)))
+		g.Expect(resp.InstanceUid).To(Equal(proc.Uid))
+		g.Expect(resp.Instance).To(Equal(int64(proc.Pair.Pid)))
+	})
+
+	t.Run("kill the proxy with invalid rules", func(t *testing.T) {
+		_, err := s.ApplyGrpcChaos(ctx, &pb.ApplyGrpcChaosRequest{
+			Rules:       `{`,
+			ContainerId: "container",
+			InstanceUid: proc.Uid,
+		})
+		g.Expect(err).NotTo(BeNil())
+		g.Expect(err.Error()).To(ContainSubstring("unmarshal rules"))
+
+		_, ok := s.backgroundProcessManager.GetPipes(proc.Uid)
+		g.Expect(ok).To(BeFalse())
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// Target represents the object to be selected and injected, <Request|Response>.
+type Target string
+
+const (
+	TargetRequest  Target = "Request"
+	TargetResponse Target = "Response"
+)
+
+// Config is the config put to the grpc proxy by chaos-daemon.
+type Config struct {
+	ProxyPorts []uint32                `json:"proxy_ports,omitempty"`
+	Rules      []Rule                  `json:"rules"`
+	TLS        *tproxyconfig.TLSConfig `json:"tls,omitempty"`
+}
+
+// Rule defines the injection rule of grpc without source and port.
+type Rule struct {
+	// Target is the object to be selected and injected, <Request|Response>.
+	Target Target `json:"target"`
+
+	// Selector contains the rules to select target.
+	Selector Selector `json:"selector"`
+
+	// Actions contains rules to inject target.
+	Actions Actions `json:"actions"`
+}
+
+type Selector struct {
+	// Port is a rule to select server listening on specific port.
+	Port *int32 `json:"port,omitempty"`
+
+	// Service is a rule to select target by the fully-qualified gRPC service name.
+	Service *string `json:"service,omitempty"`
+
+	// Method is a rule to select target by the gRPC method name of the service.
+	Method *string `json:"method,omitempty"`
+
+	// Metadata is a rule to select target by gRPC metadata.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Actions defines possible actions of GrpcChaos.
+type Actions struct {
+	// Abort is a rule to terminate a grpc call with the given status.
+	Abort *AbortAction `json:"abort,omitempty"`
+
+	// Delay represents the delay of the target request/response.
+	Delay *string `json:"delay,omitempty"`
+
+	// Replace is a rule to replace some contents in target.
+	Replace *ReplaceActions `json:"replace,omitempty"`
+}
+
+// AbortAction defines the abort action of GrpcChaos.
+type AbortAction struct {
+	// Code is the `grpc-status` returned to the caller.
+	Code int32 `json:"code"`
+
+	// Message is the `grpc-message` returned to the caller.
+	Message string `json:"message,omitempty"`
+}
+
+// ReplaceActions defines possible replace-actions of GrpcChaos.
+type ReplaceActions struct {
+	// Message is the protobuf-encoded message replacing every message of target.
+	Message []byte `json:"message,omitempty"`
+
+	// Metadata is a rule to replace gRPC metadata of target.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// state is the parsed config shared by the connections of the proxy.
+type state struct {
+	rules []rule
+
+	// serverTLS terminates the tls of the callers, and clientTLS dials the servers,
+	// both of them are nil if the calls are not encrypted.
+	serverTLS *tls.Config
+	clientTLS *tls.Config
+}
+
+type rule struct {
+	Rule
+
+	delay time.Duration
+}
+
+func newState(config *Config) (*state, error) {
+	s := &state{}
+	for _, r := range config.Rules {
+		if r.Target != TargetRequest && r.Target != TargetResponse {
+			return nil, errors.Errorf("unknown target %s", r.Target)
+		}
+
+		parsed := rule{Rule: r}
+		if r.Actions.Delay != nil {
+			delay, err := time.ParseDuration(*r.Actions.Delay)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse delay %s", *r.Actions.Delay)
+			}
+			parsed.delay = delay
+		}
+		s.rules = append(s.rules, parsed)
+	}
+
+	if config.TLS != nil {
+		if err := s.loadTLS(config.TLS); err != nil {
+			return nil, errors.Wrap(err, "load tls config")
+		}
+	}
+
+	return s, nil
+}
+
+func (s *state) loadTLS(config *tproxyconfig.TLSConfig) error {
+	cert, err := readTLSItem(&config.CertFile)
+	if err != nil {
+		return errors.Wrap(err, "read cert")
+	}
+	key, err := readTLSItem(&config.KeyFile)
+	if err != nil {
+		return errors.Wrap(err, "read key")
+	}
+	certificate, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return err
+	}
+	s.serverTLS = &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		NextProtos:   []string{"h2"},
+	}
+
+	// the server is dialed by the address of the pod, so its certificate is only verified if the ca is given,
+	// with the authority of the call as the server name
+	s.clientTLS = &tls.Config{InsecureSkipVerify: true}
+	if config.CAFile != nil {
+		ca, err := readTLSItem(config.CAFile)
+		if err != nil {
+			return errors.Wrap(err, "read ca")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return errors.New("no certificate is found in ca")
+		}
+		s.clientTLS = &tls.Config{RootCAs: pool}
+	}
+
+	return nil
+}
+
+func readTLSItem(item *tproxyconfig.TLSConfigItem) ([]byte, error) {
+	switch item.Type {
+	case "Contents":
+		return item.Value, nil
+	case "Path":
+		return os.ReadFile(string(item.Value))
+	default:
+		return nil, errors.Errorf("unknown type %s", item.Type)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ServeConfig reads the config requests from r, applies them and writes the responses to w, until r is closed.
+// The protocol is the same as the one of tproxy: every request is a PUT with the json config as the body.
+func (p *Proxy) ServeConfig(r io.Reader, w io.Writer) error {
+	reader := bufio.NewReader(r)
+	for {
+		req, err := http.ReadRequest(reader)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "read config request")
+		}
+
+		code, message := p.handleConfig(req)
+		resp := &http.Response{
+			StatusCode:    code,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			ContentLength: int64(len(message)),
+			Body:          io.NopCloser(strings.NewReader(message)),
+		}
+		if err := resp.Write(w); err != nil {
+			return errors.Wrap(err, "write config response")
+		}
+	}
+}
+
+func (p *Proxy) handleConfig(req *http.Request) (int, string) {
+	defer req.Body.Close()
+
+	if req.Method != http.MethodPut {
+		return http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed", req.Method)
+	}
+
+	config := &Config{}
+	if err := json.NewDecoder(req.Body).Decode(config); err != nil {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	if err := p.Apply(config); err != nil {
+		p.log.Error(err, "apply config")
+		return http.StatusInternalServerError, err.Error()
+	}
+
+	p.log.Info("config applied", "ports", config.ProxyPorts, "rules", len(config.Rules))
+	return http.StatusOK, ""
+}
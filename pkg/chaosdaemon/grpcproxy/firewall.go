@@ -0,0 +1,92 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const chainName = "CHAOS-GRPC-PROXY"
+
+// Firewall redirects the connections to the proxied ports to the listeners of the proxy.
+type Firewall interface {
+	// Redirect redirects the incoming tcp connections to port to the local port to.
+	Redirect(port, to uint32) error
+	// Unredirect stops redirecting the connections to port, the established connections are not affected.
+	Unredirect(port, to uint32) error
+	// Close removes all the redirections.
+	Close() error
+}
+
+type iptables struct{}
+
+// NewIptables creates a firewall redirecting the connections with the nat table of iptables in current network namespace.
+// The rules left by a previous proxy are removed first.
+func NewIptables() (Firewall, error) {
+	firewall := &iptables{}
+	if err := firewall.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := firewall.run("-N", chainName); err != nil {
+		return nil, err
+	}
+	if err := firewall.run("-I", "PREROUTING", "-p", "tcp", "-j", chainName); err != nil {
+		return nil, err
+	}
+
+	return firewall, nil
+}
+
+func (f *iptables) Redirect(port, to uint32) error {
+	return f.run(append([]string{"-A"}, redirectRule(port, to)...)...)
+}
+
+func (f *iptables) Unredirect(port, to uint32) error {
+	return f.run(append([]string{"-D"}, redirectRule(port, to)...)...)
+}
+
+func (f *iptables) Close() error {
+	// the chain doesn't exist if the proxy has never run in this network namespace
+	if f.run("-L", chainName) != nil {
+		return nil
+	}
+
+	// the jump is inserted more than once if a proxy crashed before removing it
+	for f.run("-D", "PREROUTING", "-p", "tcp", "-j", chainName) == nil {
+	}
+	if err := f.run("-F", chainName); err != nil {
+		return err
+	}
+	return f.run("-X", chainName)
+}
+
+func (f *iptables) run(args ...string) error {
+	args = append([]string{"-w", "-t", "nat"}, args...)
+	out, err := exec.Command("iptables", args...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "iptables %s: %s", strings.Join(args, " "), string(out))
+	}
+	return nil
+}
+
+func redirectRule(port, to uint32) []string {
+	return []string{chainName, "-p", "tcp", "--dport", fmt.Sprint(port), "-j", "REDIRECT", "--to-ports", fmt.Sprint(to)}
+}
@@ -0,0 +1,295 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+const (
+	grpcStatusHeader  = "Grpc-Status"
+	grpcMessageHeader = "Grpc-Message"
+
+	// codeUnavailable is the status returned to the caller if the server cannot be reached
+	codeUnavailable = 14
+
+	// messagePrefixLength is the length of the compressed flag and the message length before every message
+	messagePrefixLength = 5
+)
+
+// handler forwards the calls of a connection to the server, and injects the chaos of the rules.
+type handler struct {
+	proxy *Proxy
+
+	port      uint32
+	scheme    string
+	upstream  string
+	transport *http2.Transport
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	s := h.proxy.loadState()
+
+	header := r.Header.Clone()
+	var requestMessage []byte
+	for _, rule := range s.rules {
+		if !rule.selects(TargetRequest, h.port, r.URL.Path, r.Header) {
+			continue
+		}
+		if !sleep(ctx, rule.delay) {
+			return
+		}
+		// the call is aborted without reaching the server
+		if abort := rule.Actions.Abort; abort != nil {
+			writeStatus(w, abort.Code, abort.Message)
+			return
+		}
+		if replace := rule.Actions.Replace; replace != nil {
+			for key, value := range replace.Metadata {
+				header.Set(key, value)
+			}
+			if len(replace.Message) != 0 {
+				requestMessage = replace.Message
+			}
+		}
+	}
+
+	body := r.Body
+	contentLength := r.ContentLength
+	if requestMessage != nil {
+		reader, writer := io.Pipe()
+		go func() {
+			writer.CloseWithError(replaceMessages(writer, r.Body, requestMessage, func() {}))
+		}()
+		body = reader
+		contentLength = -1
+		header.Del("Content-Length")
+	}
+
+	host := r.Host
+	if host == "" {
+		host = h.upstream
+	}
+	out := (&http.Request{
+		Method: r.Method,
+		URL: &url.URL{
+			Scheme:   h.scheme,
+			Host:     host,
+			Path:     r.URL.Path,
+			RawPath:  r.URL.RawPath,
+			RawQuery: r.URL.RawQuery,
+		},
+		Proto:         r.Proto,
+		ProtoMajor:    r.ProtoMajor,
+		ProtoMinor:    r.ProtoMinor,
+		Header:        header,
+		Body:          body,
+		ContentLength: contentLength,
+		Trailer:       r.Trailer,
+		Host:          host,
+	}).WithContext(ctx)
+
+	resp, err := h.transport.RoundTrip(out)
+	if err != nil {
+		h.proxy.log.Error(err, "forward call", "path", r.URL.Path, "upstream", h.upstream)
+		writeStatus(w, codeUnavailable, err.Error())
+		return
+	}
+	defer resp.Body.Close()
+
+	var responseMessage []byte
+	for _, rule := range s.rules {
+		if !rule.selects(TargetResponse, h.port, r.URL.Path, resp.Header) {
+			continue
+		}
+		if !sleep(ctx, rule.delay) {
+			return
+		}
+		// the response of the server is dropped, and the caller only receives the status
+		if abort := rule.Actions.Abort; abort != nil {
+			writeStatus(w, abort.Code, abort.Message)
+			return
+		}
+		if replace := rule.Actions.Replace; replace != nil {
+			for key, value := range replace.Metadata {
+				resp.Header.Set(key, value)
+			}
+			if len(replace.Message) != 0 {
+				responseMessage = replace.Message
+			}
+		}
+	}
+
+	if responseMessage != nil {
+		resp.Header.Del("Content-Length")
+	}
+	for key, values := range resp.Header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(resp.StatusCode)
+
+	flush := func() {
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+	if responseMessage != nil {
+		err = replaceMessages(w, resp.Body, responseMessage, flush)
+	} else {
+		err = copyBody(w, resp.Body, flush)
+	}
+	if err != nil {
+		h.proxy.log.Error(err, "forward response", "path", r.URL.Path, "upstream", h.upstream)
+		// reset the stream, as the status in the trailers of the server is lost
+		panic(http.ErrAbortHandler)
+	}
+
+	// the trailers are known after the body is read, and they carry the status of the call
+	for key, values := range resp.Trailer {
+		w.Header()[http.TrailerPrefix+key] = values
+	}
+}
+
+// selects returns whether the call is selected by the rule, the metadata is the headers of the target.
+func (r *rule) selects(target Target, port uint32, path string, metadata http.Header) bool {
+	if r.Target != target {
+		return false
+	}
+
+	selector := r.Selector
+	if selector.Port != nil && uint32(*selector.Port) != port {
+		return false
+	}
+
+	// the path of a grpc call is /{service}/{method}
+	service, method := path, ""
+	if index := strings.LastIndex(path, "/"); index >= 0 {
+		service, method = strings.TrimPrefix(path[:index], "/"), path[index+1:]
+	}
+	if selector.Service != nil && *selector.Service != service {
+		return false
+	}
+	if selector.Method != nil && *selector.Method != method {
+		return false
+	}
+
+	for key, value := range selector.Metadata {
+		if metadata.Get(key) != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// writeStatus responds the call with a trailers-only response, which carries the status in the headers
+// and ends the stream without any message.
+func writeStatus(w http.ResponseWriter, code int32, message string) {
+	header := w.Header()
+	header.Set("Content-Type", "application/grpc")
+	header.Set(grpcStatusHeader, strconv.Itoa(int(code)))
+	if message != "" {
+		header.Set(grpcMessageHeader, encodeGrpcMessage(message))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// encodeGrpcMessage percent-encodes the message, as the grpc-message header only allows printable ascii characters.
+func encodeGrpcMessage(message string) string {
+	var builder strings.Builder
+	for i := 0; i < len(message); i++ {
+		c := message[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
+
+// replaceMessages reads the length-prefixed messages from src, and writes the replacement instead of each of them.
+func replaceMessages(dst io.Writer, src io.Reader, replacement []byte, flush func()) error {
+	// the replacement is not compressed, whatever the encoding of the call is
+	frame := make([]byte, messagePrefixLength+len(replacement))
+	binary.BigEndian.PutUint32(frame[1:messagePrefixLength], uint32(len(replacement)))
+	copy(frame[messagePrefixLength:], replacement)
+
+	prefix := make([]byte, messagePrefixLength)
+	for {
+		if _, err := io.ReadFull(src, prefix); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if _, err := io.CopyN(io.Discard, src, int64(binary.BigEndian.Uint32(prefix[1:]))); err != nil {
+			return err
+		}
+
+		if _, err := dst.Write(frame); err != nil {
+			return err
+		}
+		flush()
+	}
+}
+
+// copyBody copies the body from src to dst, and flushes every piece to the caller to keep the stream going.
+func copyBody(dst io.Writer, src io.Reader, flush func()) error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return err
+			}
+			flush()
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func sleep(ctx context.Context, delay time.Duration) bool {
+	if delay <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
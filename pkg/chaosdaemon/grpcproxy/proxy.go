@@ -0,0 +1,216 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"crypto/tls"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"golang.org/x/net/http2"
+)
+
+// Proxy accepts the grpc calls redirected from the proxied ports, injects the chaos of the rules into them
+// and forwards them to the servers listening on the proxied ports.
+type Proxy struct {
+	sync.Mutex
+
+	firewall Firewall
+	log      logr.Logger
+
+	listeners map[uint32]*listener
+	state     atomic.Value
+}
+
+type listener struct {
+	net.Listener
+
+	// port is the proxied port, and the connections to it are redirected to the listener.
+	port uint32
+}
+
+// New creates a proxy without any proxied port, the connections are redirected to it by the firewall.
+func New(firewall Firewall, log logr.Logger) *Proxy {
+	p := &Proxy{
+		firewall:  firewall,
+		log:       log,
+		listeners: make(map[uint32]*listener),
+	}
+	p.state.Store(&state{})
+	return p
+}
+
+// Apply replaces the rules and the tls config of the proxy, and proxies the ports of the config only.
+// The calls in flight are not affected, while the following calls are injected by the new rules.
+func (p *Proxy) Apply(config *Config) error {
+	s, err := newState(config)
+	if err != nil {
+		return err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.state.Store(s)
+
+	ports := make(map[uint32]struct{})
+	for _, port := range config.ProxyPorts {
+		ports[port] = struct{}{}
+	}
+
+	for port, l := range p.listeners {
+		if _, ok := ports[port]; ok {
+			continue
+		}
+		if err := p.closeListener(l); err != nil {
+			return err
+		}
+		delete(p.listeners, port)
+	}
+
+	for port := range ports {
+		if _, ok := p.listeners[port]; ok {
+			continue
+		}
+		l, err := p.listen(port)
+		if err != nil {
+			return err
+		}
+		p.listeners[port] = l
+	}
+
+	return nil
+}
+
+// Close stops proxying all the ports and releases the firewall.
+func (p *Proxy) Close() error {
+	p.Lock()
+	defer p.Unlock()
+
+	for port, l := range p.listeners {
+		if err := p.closeListener(l); err != nil {
+			p.log.Error(err, "close listener", "port", port)
+		}
+		delete(p.listeners, port)
+	}
+
+	return p.firewall.Close()
+}
+
+func (p *Proxy) loadState() *state {
+	return p.state.Load().(*state)
+}
+
+func (p *Proxy) listen(port uint32) (*listener, error) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return nil, errors.Wrapf(err, "listen for port %d", port)
+	}
+
+	to := uint32(ln.Addr().(*net.TCPAddr).Port)
+	if err := p.firewall.Redirect(port, to); err != nil {
+		ln.Close()
+		return nil, errors.Wrapf(err, "redirect port %d to %d", port, to)
+	}
+
+	l := &listener{
+		Listener: ln,
+		port:     port,
+	}
+	go p.serve(l)
+
+	p.log.Info("proxy port", "port", port, "listen", to)
+	return l, nil
+}
+
+func (p *Proxy) closeListener(l *listener) error {
+	to := uint32(l.Addr().(*net.TCPAddr).Port)
+	if err := p.firewall.Unredirect(l.port, to); err != nil {
+		return errors.Wrapf(err, "stop redirecting port %d to %d", l.port, to)
+	}
+
+	// the accepted connections are kept, and their calls are forwarded without chaos once the rules are removed
+	return l.Close()
+}
+
+func (p *Proxy) serve(l *listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				p.log.Error(err, "accept connection", "port", l.port)
+			}
+			return
+		}
+
+		go p.serveConn(l.port, conn)
+	}
+}
+
+// serveConn serves the http/2 connection of the caller, and forwards its calls through a connection to the server.
+func (p *Proxy) serveConn(port uint32, conn net.Conn) {
+	defer conn.Close()
+
+	s := p.loadState()
+
+	// the connection is redirected to the proxy, so the server listens on the proxied port of its local address
+	local := conn.LocalAddr().(*net.TCPAddr)
+	upstream := net.JoinHostPort(local.IP.String(), strconv.Itoa(int(port)))
+
+	scheme := "http"
+	if s.serverTLS != nil {
+		tlsConn := tls.Server(conn, s.serverTLS)
+		if err := tlsConn.Handshake(); err != nil {
+			p.log.Error(err, "tls handshake", "remote", conn.RemoteAddr())
+			return
+		}
+		conn = tlsConn
+		scheme = "https"
+	}
+
+	transport := newTransport(upstream, s.clientTLS)
+	defer transport.CloseIdleConnections()
+
+	server := &http2.Server{}
+	server.ServeConn(conn, &http2.ServeConnOpts{
+		Handler: &handler{
+			proxy:     p,
+			port:      port,
+			scheme:    scheme,
+			upstream:  upstream,
+			transport: transport,
+		},
+	})
+}
+
+// newTransport creates a http/2 transport to the server, with tls if clientTLS is not nil, or with prior knowledge.
+func newTransport(upstream string, clientTLS *tls.Config) *http2.Transport {
+	return &http2.Transport{
+		AllowHTTP:       true,
+		TLSClientConfig: clientTLS,
+		// the calls are dialed to the server whatever the authority is
+		DialTLS: func(network, _ string, config *tls.Config) (net.Conn, error) {
+			if clientTLS == nil {
+				return net.Dial(network, upstream)
+			}
+			return tls.Dial(network, upstream, config)
+		},
+	}
+}
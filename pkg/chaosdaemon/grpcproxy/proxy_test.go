@@ -0,0 +1,285 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+)
+
+// fakeFirewall records the redirections, and the clients connect to the listeners of the proxy directly
+type fakeFirewall struct {
+	sync.Mutex
+	redirects map[uint32]uint32
+}
+
+func (f *fakeFirewall) Redirect(port, to uint32) error {
+	f.Lock()
+	defer f.Unlock()
+	f.redirects[port] = to
+	return nil
+}
+
+func (f *fakeFirewall) Unredirect(port, _ uint32) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.redirects, port)
+	return nil
+}
+
+func (f *fakeFirewall) Close() error {
+	return nil
+}
+
+// metadataServer echoes the metadata of the call in the response headers
+type metadataServer struct {
+	grpc_health_v1.HealthServer
+}
+
+func (s metadataServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-chaos"); len(values) > 0 {
+		if err := grpc.SetHeader(ctx, metadata.Pairs("x-chaos", values[0])); err != nil {
+			return nil, err
+		}
+	}
+	return s.HealthServer.Check(ctx, req)
+}
+
+func TestProxy(t *testing.T) {
+	g := NewWithT(t)
+
+	logger, err := log.NewDefaultZapLogger()
+	g.Expect(err).To(BeNil())
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("chaos", grpc_health_v1.HealthCheckResponse_SERVING)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, metadataServer{healthServer})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).To(BeNil())
+	go server.Serve(ln)
+	defer server.Stop()
+	port := uint32(ln.Addr().(*net.TCPAddr).Port)
+
+	firewall := &fakeFirewall{redirects: make(map[uint32]uint32)}
+	proxy := New(firewall, logger)
+	defer proxy.Close()
+
+	apply := func(rules ...Rule) {
+		g.Expect(proxy.Apply(&Config{ProxyPorts: []uint32{port}, Rules: rules})).To(BeNil())
+	}
+	apply()
+	g.Expect(firewall.redirects).To(HaveKey(port))
+
+	conn, err := grpc.Dial(net.JoinHostPort("127.0.0.1", fmt.Sprint(firewall.redirects[port])),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	g.Expect(err).To(BeNil())
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	check := func(opts ...grpc.CallOption) (*grpc_health_v1.HealthCheckResponse, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "chaos"}, opts...)
+	}
+
+	service := "grpc.health.v1.Health"
+	method := "Check"
+	delay := "500ms"
+
+	t.Run("forward without rules", func(t *testing.T) {
+		resp, err := check()
+		g.Expect(err).To(BeNil())
+		g.Expect(resp.Status).To(Equal(grpc_health_v1.HealthCheckResponse_SERVING))
+	})
+
+	t.Run("abort the response", func(t *testing.T) {
+		apply(Rule{
+			Target:   TargetResponse,
+			Selector: Selector{Service: &service, Method: &method},
+			Actions:  Actions{Abort: &AbortAction{Code: int32(codes.Unavailable), Message: "chaos 故障 100%"}},
+		})
+
+		_, err := check()
+		s, ok := status.FromError(err)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(s.Code()).To(Equal(codes.Unavailable))
+		g.Expect(s.Message()).To(Equal("chaos 故障 100%"))
+	})
+
+	t.Run("abort the request", func(t *testing.T) {
+		apply(Rule{
+			Target:   TargetRequest,
+			Selector: Selector{Method: &method},
+			Actions:  Actions{Abort: &AbortAction{Code: int32(codes.PermissionDenied)}},
+		})
+
+		_, err := check()
+		g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	t.Run("skip the unselected calls", func(t *testing.T) {
+		other := "Watch"
+		apply(Rule{
+			Target:   TargetRequest,
+			Selector: Selector{Method: &other},
+			Actions:  Actions{Abort: &AbortAction{Code: int32(codes.PermissionDenied)}},
+		})
+
+		_, err := check()
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("delay the response", func(t *testing.T) {
+		apply(Rule{
+			Target:  TargetResponse,
+			Actions: Actions{Delay: &delay},
+		})
+
+		start := time.Now()
+		_, err := check()
+		g.Expect(err).To(BeNil())
+		g.Expect(time.Since(start)).To(BeNumerically(">=", 500*time.Millisecond))
+	})
+
+	t.Run("replace the response message", func(t *testing.T) {
+		message, err := proto.Marshal(&grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING})
+		g.Expect(err).To(BeNil())
+		apply(Rule{
+			Target:  TargetResponse,
+			Actions: Actions{Replace: &ReplaceActions{Message: message}},
+		})
+
+		resp, err := check()
+		g.Expect(err).To(BeNil())
+		g.Expect(resp.Status).To(Equal(grpc_health_v1.HealthCheckResponse_NOT_SERVING))
+	})
+
+	t.Run("replace the request message", func(t *testing.T) {
+		message, err := proto.Marshal(&grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+		g.Expect(err).To(BeNil())
+		apply(Rule{
+			Target:  TargetRequest,
+			Actions: Actions{Replace: &ReplaceActions{Message: message}},
+		})
+
+		_, err = check()
+		g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	t.Run("select and replace the metadata", func(t *testing.T) {
+		apply(
+			Rule{
+				Target:   TargetRequest,
+				Selector: Selector{Metadata: map[string]string{"x-user": "chaos"}},
+				Actions:  Actions{Replace: &ReplaceActions{Metadata: map[string]string{"x-chaos": "request"}}},
+			},
+			Rule{
+				Target:   TargetResponse,
+				Selector: Selector{Metadata: map[string]string{"x-chaos": "request"}},
+				Actions:  Actions{Replace: &ReplaceActions{Metadata: map[string]string{"x-chaos": "response"}}},
+			},
+		)
+
+		var header metadata.MD
+		_, err := check(grpc.Header(&header))
+		g.Expect(err).To(BeNil())
+		g.Expect(header.Get("x-chaos")).To(BeEmpty())
+
+		ctx, cancel := context.WithTimeout(metadata.AppendToOutgoingContext(context.Background(), "x-user", "chaos"), 5*time.Second)
+		defer cancel()
+		_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "chaos"}, grpc.Header(&header))
+		g.Expect(err).To(BeNil())
+		g.Expect(header.Get("x-chaos")).To(Equal([]string{"response"}))
+	})
+
+	t.Run("stop proxying the removed ports", func(t *testing.T) {
+		g.Expect(proxy.Apply(&Config{})).To(BeNil())
+		g.Expect(firewall.redirects).To(BeEmpty())
+	})
+}
+
+func TestServeConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	logger, err := log.NewDefaultZapLogger()
+	g.Expect(err).To(BeNil())
+	proxy := New(&fakeFirewall{redirects: make(map[uint32]uint32)}, logger)
+	defer proxy.Close()
+
+	delay := "1s"
+	invalid := "invalid"
+	requests := []struct {
+		method string
+		body   interface{}
+		code   int
+	}{
+		{http.MethodPut, &Config{Rules: []Rule{{Target: TargetRequest, Actions: Actions{Delay: &delay}}}}, http.StatusOK},
+		{http.MethodPut, "{", http.StatusBadRequest},
+		{http.MethodPut, &Config{Rules: []Rule{{Target: TargetRequest, Actions: Actions{Delay: &invalid}}}}, http.StatusInternalServerError},
+		{http.MethodGet, nil, http.StatusMethodNotAllowed},
+	}
+
+	input := &bytes.Buffer{}
+	for _, r := range requests {
+		var body []byte
+		switch b := r.body.(type) {
+		case nil:
+		case string:
+			body = []byte(b)
+		default:
+			body, err = json.Marshal(b)
+			g.Expect(err).To(BeNil())
+		}
+
+		req, err := http.NewRequest(r.method, "/", bytes.NewReader(body))
+		g.Expect(err).To(BeNil())
+		g.Expect(req.Write(input)).To(BeNil())
+	}
+
+	output := &bytes.Buffer{}
+	g.Expect(proxy.ServeConfig(input, output)).To(BeNil())
+
+	reader := bufio.NewReader(output)
+	for _, r := range requests {
+		resp, err := http.ReadResponse(reader, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(resp.StatusCode).To(Equal(r.code))
+		resp.Body.Close()
+	}
+}
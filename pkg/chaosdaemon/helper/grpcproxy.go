@@ -0,0 +1,78 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/grpcproxy"
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+)
+
+var GrpcProxyCmd = &cobra.Command{
+	Use:   "grpc-proxy",
+	Short: "inject chaos into the grpc calls of current network namespace",
+	Long: `Inject chaos into the grpc calls to the proxied ports of current network namespace.
+The config is read from stdin as http PUT requests, and the responses are written to stdout.
+The connections are redirected to the proxy with iptables, and the redirections are removed on exit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runGrpcProxy(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func runGrpcProxy() error {
+	logger, err := log.NewDefaultZapLogger()
+	if err != nil {
+		return err
+	}
+
+	firewall, err := grpcproxy.NewIptables()
+	if err != nil {
+		return err
+	}
+	proxy := grpcproxy.New(firewall, logger.WithName("grpc-proxy"))
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- proxy.ServeConfig(os.Stdin, os.Stdout)
+	}()
+
+	// the proxy keeps running after stdin is closed, until chaos-daemon kills it
+	select {
+	case sig := <-signals:
+		logger.Info("receive signal, stop proxy", "signal", sig)
+	case err = <-done:
+		if err == nil {
+			<-signals
+		}
+	}
+
+	if closeErr := proxy.Close(); closeErr != nil {
+		return closeErr
+	}
+	return err
+}
@@ -39,6 +39,16 @@ func MergeNetem(a, b *chaosdaemon.Netem) *chaosdaemon.Netem {
 	if b == nil {
 		b = &chaosdaemon.Netem{}
 	}
+	// the distribution can't be merged, so the one from the netem with the bigger jitter is taken
+	distribution, distributionTable := a.GetDistribution(), a.GetDistributionTable()
+	if distribution == "" || b.GetJitter() > a.GetJitter() && b.GetDistribution() != "" {
+		distribution, distributionTable = b.GetDistribution(), b.GetDistributionTable()
+	}
+	// the loss model can't be merged either, so the one with the bigger transition probability to the bad state is taken
+	lossGEModel := a.GetLossGemodel()
+	if b.GetLossGemodel().GetP() > lossGEModel.GetP() {
+		lossGEModel = b.GetLossGemodel()
+	}
 	return &chaosdaemon.Netem{
 		Time:          maxu32(a.GetTime(), b.GetTime()),
 		Jitter:        maxu32(a.GetJitter(), b.GetJitter()),
@@ -53,6 +63,10 @@ func MergeNetem(a, b *chaosdaemon.Netem) *chaosdaemon.Netem {
 		ReorderCorr:   maxf32(a.GetReorderCorr(), b.GetReorderCorr()),
 		Corrupt:       maxf32(a.GetCorrupt(), b.GetCorrupt()),
 		CorruptCorr:   maxf32(a.GetCorruptCorr(), b.GetCorruptCorr()),
+
+		Distribution:      distribution,
+		DistributionTable: distributionTable,
+		LossGemodel:       lossGEModel,
 	}
 }
 
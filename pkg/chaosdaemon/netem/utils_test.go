@@ -44,6 +44,12 @@ func TestMergeNetem(t *testing.T) {
 			&chaosdaemonpb.Netem{DelayCorr: 90},
 			&chaosdaemonpb.Netem{Loss: 25, DelayCorr: 100.2},
 		},
+		{
+			// pick the distribution with the bigger jitter
+			&chaosdaemonpb.Netem{Jitter: 10, Distribution: "normal"},
+			&chaosdaemonpb.Netem{Jitter: 20, Distribution: "pareto"},
+			&chaosdaemonpb.Netem{Jitter: 20, Distribution: "pareto"},
+		},
 	}
 
 	for _, tc := range cases {
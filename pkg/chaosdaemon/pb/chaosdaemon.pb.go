@@ -74,14 +74,16 @@ func (x Chain_Direction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Chain_Direction.Descriptor instead.
 func (Chain_Direction) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{17, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type ContainerAction_Action int32
 
 const (
-	ContainerAction_KILL   ContainerAction_Action = 0
-	ContainerAction_GETPID ContainerAction_Action = 1
+	ContainerAction_KILL    ContainerAction_Action = 0
+	ContainerAction_GETPID  ContainerAction_Action = 1
+	ContainerAction_PAUSE   ContainerAction_Action = 2
+	ContainerAction_UNPAUSE ContainerAction_Action = 3
 )
 
 // Enum value maps for ContainerAction_Action.
@@ -89,10 +91,14 @@ var (
 	ContainerAction_Action_name = map[int32]string{
 		0: "KILL",
 		1: "GETPID",
+		2: "PAUSE",
+		3: "UNPAUSE",
 	}
 	ContainerAction_Action_value = map[string]int32{
-		"KILL":   0,
-		"GETPID": 1,
+		"KILL":    0,
+		"GETPID":  1,
+		"PAUSE":   2,
+		"UNPAUSE": 3,
 	}
 )
 
@@ -120,7 +126,7 @@ func (x ContainerAction_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContainerAction_Action.Descriptor instead.
 func (ContainerAction_Action) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{19, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type ExecStressRequest_Scope int32
@@ -166,7 +172,7 @@ func (x ExecStressRequest_Scope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExecStressRequest_Scope.Descriptor instead.
 func (ExecStressRequest_Scope) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{20, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{24, 0}
 }
 
 type Tc_Type int32
@@ -212,7 +218,7 @@ func (x Tc_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Tc_Type.Descriptor instead.
 func (Tc_Type) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{28, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type ApplyBlockChaosRequest_Action int32
@@ -255,7 +261,7 @@ func (x ApplyBlockChaosRequest_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApplyBlockChaosRequest_Action.Descriptor instead.
 func (ApplyBlockChaosRequest_Action) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{32, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{38, 0}
 }
 
 type TcHandle struct {
@@ -415,6 +421,179 @@ func (x *ContainerResponse) GetPid() uint32 {
 	return 0
 }
 
+type KillProcessesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId    string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	NamePattern    string `protobuf:"bytes,2,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	CmdlinePattern string `protobuf:"bytes,3,opt,name=cmdline_pattern,json=cmdlinePattern,proto3" json:"cmdline_pattern,omitempty"`
+	Signal         uint32 `protobuf:"varint,4,opt,name=signal,proto3" json:"signal,omitempty"`
+}
+
+func (x *KillProcessesRequest) Reset() {
+	*x = KillProcessesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillProcessesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillProcessesRequest) ProtoMessage() {}
+
+func (x *KillProcessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillProcessesRequest.ProtoReflect.Descriptor instead.
+func (*KillProcessesRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KillProcessesRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *KillProcessesRequest) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *KillProcessesRequest) GetCmdlinePattern() string {
+	if x != nil {
+		return x.CmdlinePattern
+	}
+	return ""
+}
+
+func (x *KillProcessesRequest) GetSignal() uint32 {
+	if x != nil {
+		return x.Signal
+	}
+	return 0
+}
+
+type KilledProcess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid     uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *KilledProcess) Reset() {
+	*x = KilledProcess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KilledProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KilledProcess) ProtoMessage() {}
+
+func (x *KilledProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KilledProcess.ProtoReflect.Descriptor instead.
+func (*KilledProcess) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KilledProcess) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *KilledProcess) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type KillProcessesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Processes []*KilledProcess `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+}
+
+func (x *KillProcessesResponse) Reset() {
+	*x = KillProcessesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillProcessesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillProcessesResponse) ProtoMessage() {}
+
+func (x *KillProcessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillProcessesResponse.ProtoReflect.Descriptor instead.
+func (*KillProcessesResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KillProcessesResponse) GetProcesses() []*KilledProcess {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
 type NetemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -429,7 +608,7 @@ type NetemRequest struct {
 func (x *NetemRequest) Reset() {
 	*x = NetemRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[3]
+		mi := &file_chaosdaemon_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -442,7 +621,7 @@ func (x *NetemRequest) String() string {
 func (*NetemRequest) ProtoMessage() {}
 
 func (x *NetemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[3]
+	mi := &file_chaosdaemon_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +634,7 @@ func (x *NetemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetemRequest.ProtoReflect.Descriptor instead.
 func (*NetemRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{3}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NetemRequest) GetNetem() *Netem {
@@ -491,27 +670,30 @@ type Netem struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Time          uint32    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Jitter        uint32    `protobuf:"varint,2,opt,name=jitter,proto3" json:"jitter,omitempty"`
-	DelayCorr     float32   `protobuf:"fixed32,3,opt,name=delay_corr,json=delayCorr,proto3" json:"delay_corr,omitempty"`
-	Limit         uint32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Loss          float32   `protobuf:"fixed32,5,opt,name=loss,proto3" json:"loss,omitempty"`
-	LossCorr      float32   `protobuf:"fixed32,6,opt,name=loss_corr,json=lossCorr,proto3" json:"loss_corr,omitempty"`
-	Gap           uint32    `protobuf:"varint,7,opt,name=gap,proto3" json:"gap,omitempty"`
-	Duplicate     float32   `protobuf:"fixed32,8,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
-	DuplicateCorr float32   `protobuf:"fixed32,9,opt,name=duplicate_corr,json=duplicateCorr,proto3" json:"duplicate_corr,omitempty"`
-	Reorder       float32   `protobuf:"fixed32,10,opt,name=reorder,proto3" json:"reorder,omitempty"`
-	ReorderCorr   float32   `protobuf:"fixed32,11,opt,name=reorder_corr,json=reorderCorr,proto3" json:"reorder_corr,omitempty"`
-	Corrupt       float32   `protobuf:"fixed32,12,opt,name=corrupt,proto3" json:"corrupt,omitempty"`
-	CorruptCorr   float32   `protobuf:"fixed32,13,opt,name=corrupt_corr,json=corruptCorr,proto3" json:"corrupt_corr,omitempty"`
-	Parent        *TcHandle `protobuf:"bytes,14,opt,name=parent,proto3" json:"parent,omitempty"`
-	Handle        *TcHandle `protobuf:"bytes,15,opt,name=handle,proto3" json:"handle,omitempty"`
+	Time              uint32       `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Jitter            uint32       `protobuf:"varint,2,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	DelayCorr         float32      `protobuf:"fixed32,3,opt,name=delay_corr,json=delayCorr,proto3" json:"delay_corr,omitempty"`
+	Limit             uint32       `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Loss              float32      `protobuf:"fixed32,5,opt,name=loss,proto3" json:"loss,omitempty"`
+	LossCorr          float32      `protobuf:"fixed32,6,opt,name=loss_corr,json=lossCorr,proto3" json:"loss_corr,omitempty"`
+	Gap               uint32       `protobuf:"varint,7,opt,name=gap,proto3" json:"gap,omitempty"`
+	Duplicate         float32      `protobuf:"fixed32,8,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	DuplicateCorr     float32      `protobuf:"fixed32,9,opt,name=duplicate_corr,json=duplicateCorr,proto3" json:"duplicate_corr,omitempty"`
+	Reorder           float32      `protobuf:"fixed32,10,opt,name=reorder,proto3" json:"reorder,omitempty"`
+	ReorderCorr       float32      `protobuf:"fixed32,11,opt,name=reorder_corr,json=reorderCorr,proto3" json:"reorder_corr,omitempty"`
+	Corrupt           float32      `protobuf:"fixed32,12,opt,name=corrupt,proto3" json:"corrupt,omitempty"`
+	CorruptCorr       float32      `protobuf:"fixed32,13,opt,name=corrupt_corr,json=corruptCorr,proto3" json:"corrupt_corr,omitempty"`
+	Parent            *TcHandle    `protobuf:"bytes,14,opt,name=parent,proto3" json:"parent,omitempty"`
+	Handle            *TcHandle    `protobuf:"bytes,15,opt,name=handle,proto3" json:"handle,omitempty"`
+	Distribution      string       `protobuf:"bytes,16,opt,name=distribution,proto3" json:"distribution,omitempty"`
+	DistributionTable []int32      `protobuf:"varint,17,rep,packed,name=distribution_table,json=distributionTable,proto3" json:"distribution_table,omitempty"`
+	LossGemodel       *LossGEModel `protobuf:"bytes,18,opt,name=loss_gemodel,json=lossGemodel,proto3" json:"loss_gemodel,omitempty"`
 }
 
 func (x *Netem) Reset() {
 	*x = Netem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[4]
+		mi := &file_chaosdaemon_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +706,7 @@ func (x *Netem) String() string {
 func (*Netem) ProtoMessage() {}
 
 func (x *Netem) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[4]
+	mi := &file_chaosdaemon_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +719,7 @@ func (x *Netem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Netem.ProtoReflect.Descriptor instead.
 func (*Netem) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{4}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Netem) GetTime() uint32 {
@@ -645,6 +827,98 @@ func (x *Netem) GetHandle() *TcHandle {
 	return nil
 }
 
+func (x *Netem) GetDistribution() string {
+	if x != nil {
+		return x.Distribution
+	}
+	return ""
+}
+
+func (x *Netem) GetDistributionTable() []int32 {
+	if x != nil {
+		return x.DistributionTable
+	}
+	return nil
+}
+
+func (x *Netem) GetLossGemodel() *LossGEModel {
+	if x != nil {
+		return x.LossGemodel
+	}
+	return nil
+}
+
+type LossGEModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	P        float32 `protobuf:"fixed32,1,opt,name=p,proto3" json:"p,omitempty"`
+	R        float32 `protobuf:"fixed32,2,opt,name=r,proto3" json:"r,omitempty"`
+	BadLoss  float32 `protobuf:"fixed32,3,opt,name=bad_loss,json=badLoss,proto3" json:"bad_loss,omitempty"`
+	GoodLoss float32 `protobuf:"fixed32,4,opt,name=good_loss,json=goodLoss,proto3" json:"good_loss,omitempty"`
+}
+
+func (x *LossGEModel) Reset() {
+	*x = LossGEModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LossGEModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LossGEModel) ProtoMessage() {}
+
+func (x *LossGEModel) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LossGEModel.ProtoReflect.Descriptor instead.
+func (*LossGEModel) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LossGEModel) GetP() float32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *LossGEModel) GetR() float32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *LossGEModel) GetBadLoss() float32 {
+	if x != nil {
+		return x.BadLoss
+	}
+	return 0
+}
+
+func (x *LossGEModel) GetGoodLoss() float32 {
+	if x != nil {
+		return x.GoodLoss
+	}
+	return 0
+}
+
 type TbfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -657,7 +931,7 @@ type TbfRequest struct {
 func (x *TbfRequest) Reset() {
 	*x = TbfRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[5]
+		mi := &file_chaosdaemon_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -670,7 +944,7 @@ func (x *TbfRequest) String() string {
 func (*TbfRequest) ProtoMessage() {}
 
 func (x *TbfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[5]
+	mi := &file_chaosdaemon_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +957,7 @@ func (x *TbfRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TbfRequest.ProtoReflect.Descriptor instead.
 func (*TbfRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{5}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TbfRequest) GetTbf() *Tbf {
@@ -715,7 +989,7 @@ type Tbf struct {
 func (x *Tbf) Reset() {
 	*x = Tbf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[6]
+		mi := &file_chaosdaemon_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +1002,7 @@ func (x *Tbf) String() string {
 func (*Tbf) ProtoMessage() {}
 
 func (x *Tbf) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[6]
+	mi := &file_chaosdaemon_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +1015,7 @@ func (x *Tbf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tbf.ProtoReflect.Descriptor instead.
 func (*Tbf) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{6}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Tbf) GetRate() uint64 {
@@ -791,7 +1065,7 @@ type QdiscRequest struct {
 func (x *QdiscRequest) Reset() {
 	*x = QdiscRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[7]
+		mi := &file_chaosdaemon_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +1078,7 @@ func (x *QdiscRequest) String() string {
 func (*QdiscRequest) ProtoMessage() {}
 
 func (x *QdiscRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[7]
+	mi := &file_chaosdaemon_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +1091,7 @@ func (x *QdiscRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QdiscRequest.ProtoReflect.Descriptor instead.
 func (*QdiscRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{7}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QdiscRequest) GetQdisc() *Qdisc {
@@ -848,7 +1122,7 @@ type Qdisc struct {
 func (x *Qdisc) Reset() {
 	*x = Qdisc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[8]
+		mi := &file_chaosdaemon_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -861,7 +1135,7 @@ func (x *Qdisc) String() string {
 func (*Qdisc) ProtoMessage() {}
 
 func (x *Qdisc) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[8]
+	mi := &file_chaosdaemon_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1148,7 @@ func (x *Qdisc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Qdisc.ProtoReflect.Descriptor instead.
 func (*Qdisc) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{8}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Qdisc) GetParent() *TcHandle {
@@ -917,7 +1191,7 @@ type EmatchFilterRequest struct {
 func (x *EmatchFilterRequest) Reset() {
 	*x = EmatchFilterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[9]
+		mi := &file_chaosdaemon_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -930,7 +1204,7 @@ func (x *EmatchFilterRequest) String() string {
 func (*EmatchFilterRequest) ProtoMessage() {}
 
 func (x *EmatchFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[9]
+	mi := &file_chaosdaemon_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1217,7 @@ func (x *EmatchFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmatchFilterRequest.ProtoReflect.Descriptor instead.
 func (*EmatchFilterRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{9}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EmatchFilterRequest) GetFilter() *EmatchFilter {
@@ -973,7 +1247,7 @@ type EmatchFilter struct {
 func (x *EmatchFilter) Reset() {
 	*x = EmatchFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[10]
+		mi := &file_chaosdaemon_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -986,7 +1260,7 @@ func (x *EmatchFilter) String() string {
 func (*EmatchFilter) ProtoMessage() {}
 
 func (x *EmatchFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[10]
+	mi := &file_chaosdaemon_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,7 +1273,7 @@ func (x *EmatchFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmatchFilter.ProtoReflect.Descriptor instead.
 func (*EmatchFilter) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{10}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *EmatchFilter) GetMatch() string {
@@ -1035,7 +1309,7 @@ type TcFilterRequest struct {
 func (x *TcFilterRequest) Reset() {
 	*x = TcFilterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[11]
+		mi := &file_chaosdaemon_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1048,7 +1322,7 @@ func (x *TcFilterRequest) String() string {
 func (*TcFilterRequest) ProtoMessage() {}
 
 func (x *TcFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[11]
+	mi := &file_chaosdaemon_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,7 +1335,7 @@ func (x *TcFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TcFilterRequest.ProtoReflect.Descriptor instead.
 func (*TcFilterRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{11}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TcFilterRequest) GetFilter() *TcFilter {
@@ -1089,7 +1363,7 @@ type TcFilter struct {
 func (x *TcFilter) Reset() {
 	*x = TcFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[12]
+		mi := &file_chaosdaemon_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1102,7 +1376,7 @@ func (x *TcFilter) String() string {
 func (*TcFilter) ProtoMessage() {}
 
 func (x *TcFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[12]
+	mi := &file_chaosdaemon_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1389,7 @@ func (x *TcFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TcFilter.ProtoReflect.Descriptor instead.
 func (*TcFilter) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{12}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TcFilter) GetParent() *TcHandle {
@@ -1138,7 +1412,7 @@ type IPSetsRequest struct {
 func (x *IPSetsRequest) Reset() {
 	*x = IPSetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[13]
+		mi := &file_chaosdaemon_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1151,7 +1425,7 @@ func (x *IPSetsRequest) String() string {
 func (*IPSetsRequest) ProtoMessage() {}
 
 func (x *IPSetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[13]
+	mi := &file_chaosdaemon_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,7 +1438,7 @@ func (x *IPSetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPSetsRequest.ProtoReflect.Descriptor instead.
 func (*IPSetsRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{13}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *IPSetsRequest) GetIpsets() []*IPSet {
@@ -1203,7 +1477,7 @@ type IPSet struct {
 func (x *IPSet) Reset() {
 	*x = IPSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[14]
+		mi := &file_chaosdaemon_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1216,7 +1490,7 @@ func (x *IPSet) String() string {
 func (*IPSet) ProtoMessage() {}
 
 func (x *IPSet) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[14]
+	mi := &file_chaosdaemon_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,7 +1503,7 @@ func (x *IPSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPSet.ProtoReflect.Descriptor instead.
 func (*IPSet) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{14}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *IPSet) GetName() string {
@@ -1279,7 +1553,7 @@ type CidrAndPort struct {
 func (x *CidrAndPort) Reset() {
 	*x = CidrAndPort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[15]
+		mi := &file_chaosdaemon_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1292,7 +1566,7 @@ func (x *CidrAndPort) String() string {
 func (*CidrAndPort) ProtoMessage() {}
 
 func (x *CidrAndPort) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[15]
+	mi := &file_chaosdaemon_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,7 +1579,7 @@ func (x *CidrAndPort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CidrAndPort.ProtoReflect.Descriptor instead.
 func (*CidrAndPort) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{15}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CidrAndPort) GetCidr() string {
@@ -1335,7 +1609,7 @@ type IptablesChainsRequest struct {
 func (x *IptablesChainsRequest) Reset() {
 	*x = IptablesChainsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[16]
+		mi := &file_chaosdaemon_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1348,7 +1622,7 @@ func (x *IptablesChainsRequest) String() string {
 func (*IptablesChainsRequest) ProtoMessage() {}
 
 func (x *IptablesChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[16]
+	mi := &file_chaosdaemon_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,7 +1635,7 @@ func (x *IptablesChainsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IptablesChainsRequest.ProtoReflect.Descriptor instead.
 func (*IptablesChainsRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{16}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *IptablesChainsRequest) GetChains() []*Chain {
@@ -1404,7 +1678,7 @@ type Chain struct {
 func (x *Chain) Reset() {
 	*x = Chain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[17]
+		mi := &file_chaosdaemon_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1417,7 +1691,7 @@ func (x *Chain) String() string {
 func (*Chain) ProtoMessage() {}
 
 func (x *Chain) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[17]
+	mi := &file_chaosdaemon_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1430,7 +1704,7 @@ func (x *Chain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Chain.ProtoReflect.Descriptor instead.
 func (*Chain) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{17}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Chain) GetName() string {
@@ -1507,12 +1781,13 @@ type TimeRequest struct {
 	ClkIdsMask       uint64 `protobuf:"varint,4,opt,name=clk_ids_mask,json=clkIdsMask,proto3" json:"clk_ids_mask,omitempty"`
 	Uid              string `protobuf:"bytes,5,opt,name=uid,proto3" json:"uid,omitempty"`
 	PodContainerName string `protobuf:"bytes,6,opt,name=pod_container_name,json=podContainerName,proto3" json:"pod_container_name,omitempty"`
+	DriftRate        int64  `protobuf:"varint,7,opt,name=drift_rate,json=driftRate,proto3" json:"drift_rate,omitempty"`
 }
 
 func (x *TimeRequest) Reset() {
 	*x = TimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[18]
+		mi := &file_chaosdaemon_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1525,7 +1800,7 @@ func (x *TimeRequest) String() string {
 func (*TimeRequest) ProtoMessage() {}
 
 func (x *TimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[18]
+	mi := &file_chaosdaemon_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +1813,7 @@ func (x *TimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeRequest.ProtoReflect.Descriptor instead.
 func (*TimeRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{18}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TimeRequest) GetContainerId() string {
@@ -1583,6 +1858,13 @@ func (x *TimeRequest) GetPodContainerName() string {
 	return ""
 }
 
+func (x *TimeRequest) GetDriftRate() int64 {
+	if x != nil {
+		return x.DriftRate
+	}
+	return 0
+}
+
 type ContainerAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1594,7 +1876,7 @@ type ContainerAction struct {
 func (x *ContainerAction) Reset() {
 	*x = ContainerAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[19]
+		mi := &file_chaosdaemon_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1607,7 +1889,7 @@ func (x *ContainerAction) String() string {
 func (*ContainerAction) ProtoMessage() {}
 
 func (x *ContainerAction) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[19]
+	mi := &file_chaosdaemon_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +1902,7 @@ func (x *ContainerAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerAction.ProtoReflect.Descriptor instead.
 func (*ContainerAction) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{19}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ContainerAction) GetAction() ContainerAction_Action {
@@ -1635,18 +1917,24 @@ type ExecStressRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Scope           ExecStressRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=pb.ExecStressRequest_Scope" json:"scope,omitempty"`
-	Target          string                  `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	CpuStressors    string                  `protobuf:"bytes,3,opt,name=cpuStressors,proto3" json:"cpuStressors,omitempty"`
-	EnterNS         bool                    `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
-	MemoryStressors string                  `protobuf:"bytes,5,opt,name=memoryStressors,proto3" json:"memoryStressors,omitempty"`
-	OomScoreAdj     int32                   `protobuf:"varint,7,opt,name=oomScoreAdj,proto3" json:"oomScoreAdj,omitempty"`
+	Scope              ExecStressRequest_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=pb.ExecStressRequest_Scope" json:"scope,omitempty"`
+	Target             string                  `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	CpuStressors       string                  `protobuf:"bytes,3,opt,name=cpuStressors,proto3" json:"cpuStressors,omitempty"`
+	EnterNS            bool                    `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	MemoryStressors    string                  `protobuf:"bytes,5,opt,name=memoryStressors,proto3" json:"memoryStressors,omitempty"`
+	OomScoreAdj        int32                   `protobuf:"varint,7,opt,name=oomScoreAdj,proto3" json:"oomScoreAdj,omitempty"`
+	IoStressors        string                  `protobuf:"bytes,8,opt,name=ioStressors,proto3" json:"ioStressors,omitempty"`
+	HddPath            string                  `protobuf:"bytes,9,opt,name=hddPath,proto3" json:"hddPath,omitempty"`
+	MemoryLimitPercent int32                   `protobuf:"varint,10,opt,name=memoryLimitPercent,proto3" json:"memoryLimitPercent,omitempty"`
+	MemoryRampStep     string                  `protobuf:"bytes,11,opt,name=memoryRampStep,proto3" json:"memoryRampStep,omitempty"`
+	MemoryRampInterval string                  `protobuf:"bytes,12,opt,name=memoryRampInterval,proto3" json:"memoryRampInterval,omitempty"`
+	CpuQuotaPercent    int32                   `protobuf:"varint,13,opt,name=cpuQuotaPercent,proto3" json:"cpuQuotaPercent,omitempty"`
 }
 
 func (x *ExecStressRequest) Reset() {
 	*x = ExecStressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[20]
+		mi := &file_chaosdaemon_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1659,7 +1947,7 @@ func (x *ExecStressRequest) String() string {
 func (*ExecStressRequest) ProtoMessage() {}
 
 func (x *ExecStressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[20]
+	mi := &file_chaosdaemon_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,47 +1960,89 @@ func (x *ExecStressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecStressRequest.ProtoReflect.Descriptor instead.
 func (*ExecStressRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{20}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExecStressRequest) GetScope() ExecStressRequest_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return ExecStressRequest_CONTAINER
+}
+
+func (x *ExecStressRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ExecStressRequest) GetCpuStressors() string {
+	if x != nil {
+		return x.CpuStressors
+	}
+	return ""
+}
+
+func (x *ExecStressRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+func (x *ExecStressRequest) GetMemoryStressors() string {
+	if x != nil {
+		return x.MemoryStressors
+	}
+	return ""
 }
 
-func (x *ExecStressRequest) GetScope() ExecStressRequest_Scope {
+func (x *ExecStressRequest) GetOomScoreAdj() int32 {
 	if x != nil {
-		return x.Scope
+		return x.OomScoreAdj
 	}
-	return ExecStressRequest_CONTAINER
+	return 0
 }
 
-func (x *ExecStressRequest) GetTarget() string {
+func (x *ExecStressRequest) GetIoStressors() string {
 	if x != nil {
-		return x.Target
+		return x.IoStressors
 	}
 	return ""
 }
 
-func (x *ExecStressRequest) GetCpuStressors() string {
+func (x *ExecStressRequest) GetHddPath() string {
 	if x != nil {
-		return x.CpuStressors
+		return x.HddPath
 	}
 	return ""
 }
 
-func (x *ExecStressRequest) GetEnterNS() bool {
+func (x *ExecStressRequest) GetMemoryLimitPercent() int32 {
 	if x != nil {
-		return x.EnterNS
+		return x.MemoryLimitPercent
 	}
-	return false
+	return 0
 }
 
-func (x *ExecStressRequest) GetMemoryStressors() string {
+func (x *ExecStressRequest) GetMemoryRampStep() string {
 	if x != nil {
-		return x.MemoryStressors
+		return x.MemoryRampStep
 	}
 	return ""
 }
 
-func (x *ExecStressRequest) GetOomScoreAdj() int32 {
+func (x *ExecStressRequest) GetMemoryRampInterval() string {
 	if x != nil {
-		return x.OomScoreAdj
+		return x.MemoryRampInterval
+	}
+	return ""
+}
+
+func (x *ExecStressRequest) GetCpuQuotaPercent() int32 {
+	if x != nil {
+		return x.CpuQuotaPercent
 	}
 	return 0
 }
@@ -1733,7 +2063,7 @@ type ExecStressResponse struct {
 func (x *ExecStressResponse) Reset() {
 	*x = ExecStressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[21]
+		mi := &file_chaosdaemon_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1746,7 +2076,7 @@ func (x *ExecStressResponse) String() string {
 func (*ExecStressResponse) ProtoMessage() {}
 
 func (x *ExecStressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[21]
+	mi := &file_chaosdaemon_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +2089,7 @@ func (x *ExecStressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecStressResponse.ProtoReflect.Descriptor instead.
 func (*ExecStressResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{21}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ExecStressResponse) GetCpuInstance() string {
@@ -1820,7 +2150,7 @@ type CancelStressRequest struct {
 func (x *CancelStressRequest) Reset() {
 	*x = CancelStressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[22]
+		mi := &file_chaosdaemon_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1833,7 +2163,7 @@ func (x *CancelStressRequest) String() string {
 func (*CancelStressRequest) ProtoMessage() {}
 
 func (x *CancelStressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[22]
+	mi := &file_chaosdaemon_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,7 +2176,7 @@ func (x *CancelStressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelStressRequest.ProtoReflect.Descriptor instead.
 func (*CancelStressRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{22}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CancelStressRequest) GetCpuInstance() string {
@@ -1908,7 +2238,7 @@ type ApplyIOChaosRequest struct {
 func (x *ApplyIOChaosRequest) Reset() {
 	*x = ApplyIOChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[23]
+		mi := &file_chaosdaemon_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1921,7 +2251,7 @@ func (x *ApplyIOChaosRequest) String() string {
 func (*ApplyIOChaosRequest) ProtoMessage() {}
 
 func (x *ApplyIOChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[23]
+	mi := &file_chaosdaemon_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,7 +2264,7 @@ func (x *ApplyIOChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyIOChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyIOChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{23}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ApplyIOChaosRequest) GetActions() string {
@@ -1999,7 +2329,7 @@ type ApplyIOChaosResponse struct {
 func (x *ApplyIOChaosResponse) Reset() {
 	*x = ApplyIOChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[24]
+		mi := &file_chaosdaemon_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2012,7 +2342,7 @@ func (x *ApplyIOChaosResponse) String() string {
 func (*ApplyIOChaosResponse) ProtoMessage() {}
 
 func (x *ApplyIOChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[24]
+	mi := &file_chaosdaemon_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2355,7 @@ func (x *ApplyIOChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyIOChaosResponse.ProtoReflect.Descriptor instead.
 func (*ApplyIOChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{24}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ApplyIOChaosResponse) GetInstance() int64 {
@@ -2067,7 +2397,7 @@ type ApplyHttpChaosRequest struct {
 func (x *ApplyHttpChaosRequest) Reset() {
 	*x = ApplyHttpChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[25]
+		mi := &file_chaosdaemon_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2080,7 +2410,7 @@ func (x *ApplyHttpChaosRequest) String() string {
 func (*ApplyHttpChaosRequest) ProtoMessage() {}
 
 func (x *ApplyHttpChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[25]
+	mi := &file_chaosdaemon_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,7 +2423,7 @@ func (x *ApplyHttpChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyHttpChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyHttpChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{25}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ApplyHttpChaosRequest) GetRules() string {
@@ -2167,7 +2497,7 @@ type ApplyHttpChaosResponse struct {
 func (x *ApplyHttpChaosResponse) Reset() {
 	*x = ApplyHttpChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[26]
+		mi := &file_chaosdaemon_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2180,7 +2510,7 @@ func (x *ApplyHttpChaosResponse) String() string {
 func (*ApplyHttpChaosResponse) ProtoMessage() {}
 
 func (x *ApplyHttpChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[26]
+	mi := &file_chaosdaemon_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,7 +2523,7 @@ func (x *ApplyHttpChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyHttpChaosResponse.ProtoReflect.Descriptor instead.
 func (*ApplyHttpChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{26}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ApplyHttpChaosResponse) GetInstance() int64 {
@@ -2231,6 +2561,188 @@ func (x *ApplyHttpChaosResponse) GetInstanceUid() string {
 	return ""
 }
 
+type ApplyGrpcChaosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules       string   `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	ProxyPorts  []uint32 `protobuf:"varint,2,rep,packed,name=proxy_ports,json=proxyPorts,proto3" json:"proxy_ports,omitempty"`
+	ContainerId string   `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Instance    int64    `protobuf:"varint,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	StartTime   int64    `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EnterNS     bool     `protobuf:"varint,6,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	InstanceUid string   `protobuf:"bytes,7,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	Tls         string   `protobuf:"bytes,8,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *ApplyGrpcChaosRequest) Reset() {
+	*x = ApplyGrpcChaosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyGrpcChaosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyGrpcChaosRequest) ProtoMessage() {}
+
+func (x *ApplyGrpcChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyGrpcChaosRequest.ProtoReflect.Descriptor instead.
+func (*ApplyGrpcChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApplyGrpcChaosRequest) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+func (x *ApplyGrpcChaosRequest) GetProxyPorts() []uint32 {
+	if x != nil {
+		return x.ProxyPorts
+	}
+	return nil
+}
+
+func (x *ApplyGrpcChaosRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ApplyGrpcChaosRequest) GetInstance() int64 {
+	if x != nil {
+		return x.Instance
+	}
+	return 0
+}
+
+func (x *ApplyGrpcChaosRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ApplyGrpcChaosRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+func (x *ApplyGrpcChaosRequest) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
+func (x *ApplyGrpcChaosRequest) GetTls() string {
+	if x != nil {
+		return x.Tls
+	}
+	return ""
+}
+
+type ApplyGrpcChaosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instance    int64  `protobuf:"varint,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	StartTime   int64  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StatusCode  int32  `protobuf:"varint,3,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Error       string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	InstanceUid string `protobuf:"bytes,5,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+}
+
+func (x *ApplyGrpcChaosResponse) Reset() {
+	*x = ApplyGrpcChaosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyGrpcChaosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyGrpcChaosResponse) ProtoMessage() {}
+
+func (x *ApplyGrpcChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyGrpcChaosResponse.ProtoReflect.Descriptor instead.
+func (*ApplyGrpcChaosResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ApplyGrpcChaosResponse) GetInstance() int64 {
+	if x != nil {
+		return x.Instance
+	}
+	return 0
+}
+
+func (x *ApplyGrpcChaosResponse) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ApplyGrpcChaosResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ApplyGrpcChaosResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ApplyGrpcChaosResponse) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
 type TcsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2244,7 +2756,7 @@ type TcsRequest struct {
 func (x *TcsRequest) Reset() {
 	*x = TcsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[27]
+		mi := &file_chaosdaemon_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2257,7 +2769,7 @@ func (x *TcsRequest) String() string {
 func (*TcsRequest) ProtoMessage() {}
 
 func (x *TcsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[27]
+	mi := &file_chaosdaemon_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,7 +2782,7 @@ func (x *TcsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TcsRequest.ProtoReflect.Descriptor instead.
 func (*TcsRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{27}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *TcsRequest) GetTcs() []*Tc {
@@ -2312,7 +2824,7 @@ type Tc struct {
 func (x *Tc) Reset() {
 	*x = Tc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[28]
+		mi := &file_chaosdaemon_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2325,7 +2837,7 @@ func (x *Tc) String() string {
 func (*Tc) ProtoMessage() {}
 
 func (x *Tc) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[28]
+	mi := &file_chaosdaemon_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2338,7 +2850,7 @@ func (x *Tc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tc.ProtoReflect.Descriptor instead.
 func (*Tc) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{28}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *Tc) GetType() Tc_Type {
@@ -2411,7 +2923,7 @@ type SetDNSServerRequest struct {
 func (x *SetDNSServerRequest) Reset() {
 	*x = SetDNSServerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[29]
+		mi := &file_chaosdaemon_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2424,7 +2936,7 @@ func (x *SetDNSServerRequest) String() string {
 func (*SetDNSServerRequest) ProtoMessage() {}
 
 func (x *SetDNSServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[29]
+	mi := &file_chaosdaemon_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,7 +2949,7 @@ func (x *SetDNSServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDNSServerRequest.ProtoReflect.Descriptor instead.
 func (*SetDNSServerRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{29}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SetDNSServerRequest) GetContainerId() string {
@@ -2482,7 +2994,7 @@ type InstallJVMRulesRequest struct {
 func (x *InstallJVMRulesRequest) Reset() {
 	*x = InstallJVMRulesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[30]
+		mi := &file_chaosdaemon_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2495,7 +3007,7 @@ func (x *InstallJVMRulesRequest) String() string {
 func (*InstallJVMRulesRequest) ProtoMessage() {}
 
 func (x *InstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[30]
+	mi := &file_chaosdaemon_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +3020,7 @@ func (x *InstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallJVMRulesRequest.ProtoReflect.Descriptor instead.
 func (*InstallJVMRulesRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{30}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *InstallJVMRulesRequest) GetContainerId() string {
@@ -2553,7 +3065,7 @@ type UninstallJVMRulesRequest struct {
 func (x *UninstallJVMRulesRequest) Reset() {
 	*x = UninstallJVMRulesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[31]
+		mi := &file_chaosdaemon_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2566,7 +3078,7 @@ func (x *UninstallJVMRulesRequest) String() string {
 func (*UninstallJVMRulesRequest) ProtoMessage() {}
 
 func (x *UninstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[31]
+	mi := &file_chaosdaemon_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2579,7 +3091,7 @@ func (x *UninstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UninstallJVMRulesRequest.ProtoReflect.Descriptor instead.
 func (*UninstallJVMRulesRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{31}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UninstallJVMRulesRequest) GetContainerId() string {
@@ -2625,7 +3137,7 @@ type ApplyBlockChaosRequest struct {
 func (x *ApplyBlockChaosRequest) Reset() {
 	*x = ApplyBlockChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[32]
+		mi := &file_chaosdaemon_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2638,7 +3150,7 @@ func (x *ApplyBlockChaosRequest) String() string {
 func (*ApplyBlockChaosRequest) ProtoMessage() {}
 
 func (x *ApplyBlockChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[32]
+	mi := &file_chaosdaemon_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2651,7 +3163,7 @@ func (x *ApplyBlockChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyBlockChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyBlockChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{32}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ApplyBlockChaosRequest) GetContainerId() string {
@@ -2702,7 +3214,7 @@ type BlockDelaySpec struct {
 func (x *BlockDelaySpec) Reset() {
 	*x = BlockDelaySpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[33]
+		mi := &file_chaosdaemon_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2715,7 +3227,7 @@ func (x *BlockDelaySpec) String() string {
 func (*BlockDelaySpec) ProtoMessage() {}
 
 func (x *BlockDelaySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[33]
+	mi := &file_chaosdaemon_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2728,7 +3240,7 @@ func (x *BlockDelaySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockDelaySpec.ProtoReflect.Descriptor instead.
 func (*BlockDelaySpec) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{33}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *BlockDelaySpec) GetDelay() int64 {
@@ -2764,7 +3276,7 @@ type BlockLimitSpec struct {
 func (x *BlockLimitSpec) Reset() {
 	*x = BlockLimitSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[34]
+		mi := &file_chaosdaemon_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2777,7 +3289,7 @@ func (x *BlockLimitSpec) String() string {
 func (*BlockLimitSpec) ProtoMessage() {}
 
 func (x *BlockLimitSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[34]
+	mi := &file_chaosdaemon_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,7 +3302,7 @@ func (x *BlockLimitSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockLimitSpec.ProtoReflect.Descriptor instead.
 func (*BlockLimitSpec) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{34}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *BlockLimitSpec) GetQuota() uint64 {
@@ -2818,7 +3330,7 @@ type ApplyBlockChaosResponse struct {
 func (x *ApplyBlockChaosResponse) Reset() {
 	*x = ApplyBlockChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[35]
+		mi := &file_chaosdaemon_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2831,7 +3343,7 @@ func (x *ApplyBlockChaosResponse) String() string {
 func (*ApplyBlockChaosResponse) ProtoMessage() {}
 
 func (x *ApplyBlockChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[35]
+	mi := &file_chaosdaemon_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2844,7 +3356,7 @@ func (x *ApplyBlockChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyBlockChaosResponse.ProtoReflect.Descriptor instead.
 func (*ApplyBlockChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{35}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ApplyBlockChaosResponse) GetInjectionId() int32 {
@@ -2865,7 +3377,7 @@ type RecoverBlockChaosRequest struct {
 func (x *RecoverBlockChaosRequest) Reset() {
 	*x = RecoverBlockChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[36]
+		mi := &file_chaosdaemon_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2878,7 +3390,7 @@ func (x *RecoverBlockChaosRequest) String() string {
 func (*RecoverBlockChaosRequest) ProtoMessage() {}
 
 func (x *RecoverBlockChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[36]
+	mi := &file_chaosdaemon_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,7 +3403,7 @@ func (x *RecoverBlockChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecoverBlockChaosRequest.ProtoReflect.Descriptor instead.
 func (*RecoverBlockChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{36}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RecoverBlockChaosRequest) GetInjectionId() int32 {
@@ -2919,194 +3431,229 @@ var file_chaosdaemon_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
 	0x22, 0x25, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x65,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74,
-	0x65, 0x6d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06,
-	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64,
-	0x6c, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x4b, 0x69, 0x6c, 0x6c,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x3b, 0x0a, 0x0d, 0x4b, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x15, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x9e,
+	0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54,
+	0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22,
+	0xbd, 0x04, 0x0a, 0x05, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x63,
+	0x6f, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x43, 0x6f, 0x72, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x6c, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x67,
+	0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x67, 0x61, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0d, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x72, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x07, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x72,
+	0x72, 0x75, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x12, 0x24, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xb6, 0x03, 0x0a, 0x05, 0x4e, 0x65, 0x74,
-	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1d,
-	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x72, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x73, 0x73, 0x5f,
-	0x63, 0x6f, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x6f, 0x73, 0x73,
-	0x43, 0x6f, 0x72, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x03, 0x67, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x64, 0x75,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x72, 0x65,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72,
-	0x75, 0x70, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75,
-	0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x5f, 0x63, 0x6f,
-	0x72, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70,
-	0x74, 0x43, 0x6f, 0x72, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
-	0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x22, 0x4a, 0x0a, 0x0a, 0x54, 0x62, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x70,
-	0x62, 0x2e, 0x54, 0x62, 0x66, 0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x81, 0x01,
-	0x0a, 0x03, 0x54, 0x62, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65, 0x61, 0x6b,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x72, 0x73,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x75, 0x72, 0x73,
-	0x74, 0x22, 0x52, 0x0a, 0x0c, 0x51, 0x64, 0x69, 0x73, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x05, 0x71, 0x64, 0x69, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x64, 0x69, 0x73, 0x63, 0x52, 0x05, 0x71, 0x64, 0x69,
-	0x73, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x05, 0x51, 0x64, 0x69, 0x73, 0x63, 0x12, 0x24,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64,
-	0x6c, 0x65, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x22, 0x62, 0x0a, 0x13, 0x45, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x11, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x73, 0x73, 0x5f, 0x67, 0x65, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x73, 0x73, 0x47, 0x45, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x0b, 0x6c, 0x6f, 0x73, 0x73, 0x47, 0x65, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22,
+	0x61, 0x0a, 0x0b, 0x4c, 0x6f, 0x73, 0x73, 0x47, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0c,
+	0x0a, 0x01, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61,
+	0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x62, 0x61,
+	0x64, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x6f, 0x6f, 0x64, 0x5f, 0x6c, 0x6f,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x4c, 0x6f,
+	0x73, 0x73, 0x22, 0x4a, 0x0a, 0x0a, 0x54, 0x62, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x62, 0x66, 0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x81,
+	0x01, 0x0a, 0x03, 0x54, 0x62, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x61, 0x6b,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65, 0x61,
+	0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x72,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x75, 0x72,
+	0x73, 0x74, 0x22, 0x52, 0x0a, 0x0c, 0x51, 0x64, 0x69, 0x73, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x71, 0x64, 0x69, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x64, 0x69, 0x73, 0x63, 0x52, 0x05, 0x71, 0x64,
+	0x69, 0x73, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
 	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x0c, 0x45, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x05, 0x51, 0x64, 0x69, 0x73, 0x63, 0x12,
+	0x24, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x22, 0x62, 0x0a, 0x13, 0x45, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x0c, 0x45, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x0f, 0x54,
+	0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x08, 0x54, 0x63, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x0f, 0x54, 0x63,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x08, 0x54, 0x63, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x0d, 0x49, 0x50, 0x53, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x69, 0x70, 0x73,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x49,
-	0x50, 0x53, 0x65, 0x74, 0x52, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x0d, 0x49, 0x50, 0x53,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x69, 0x70,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x50, 0x53, 0x65, 0x74, 0x52, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x99, 0x01, 0x0a, 0x05, 0x49,
+	0x50, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73, 0x12, 0x35,
+	0x0a, 0x0e, 0x63, 0x69, 0x64, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x69, 0x64, 0x72,
+	0x41, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0c, 0x63, 0x69, 0x64, 0x72, 0x41, 0x6e, 0x64,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x0b, 0x43, 0x69, 0x64, 0x72, 0x41, 0x6e,
+	0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x77, 0x0a,
+	0x15, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0xc3, 0x02, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x22, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x01, 0x22, 0xd7, 0x01, 0x0a,
+	0x0b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x99, 0x01, 0x0a, 0x05, 0x49, 0x50,
-	0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73, 0x12, 0x35, 0x0a,
-	0x0e, 0x63, 0x69, 0x64, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x69, 0x64, 0x72, 0x41,
-	0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0c, 0x63, 0x69, 0x64, 0x72, 0x41, 0x6e, 0x64, 0x50,
-	0x6f, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x0b, 0x43, 0x69, 0x64, 0x72, 0x41, 0x6e, 0x64,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x77, 0x0a, 0x15,
-	0x49, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0xc3, 0x02, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x22, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x0b,
-	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x63,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x6e, 0x73, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6c, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x5f,
-	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6c, 0x6b, 0x49,
-	0x64, 0x73, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x64, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x6e, 0x73, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6c, 0x6b, 0x5f, 0x69, 0x64, 0x73,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6c, 0x6b,
+	0x49, 0x64, 0x73, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x66, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x72, 0x69,
+	0x66, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x7d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
 	0x6e, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43,
 	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1e, 0x0a,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a,
 	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49, 0x4c, 0x4c, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x45, 0x54, 0x50, 0x49, 0x44, 0x10, 0x01, 0x22, 0x89, 0x02,
-	0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
-	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f,
-	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x28, 0x0a, 0x0f,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x72,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x41, 0x64, 0x6a, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x6f, 0x6d,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x22, 0x1f, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x01, 0x22, 0x82, 0x02, 0x0a, 0x12, 0x45, 0x78,
-	0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28,
-	0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64,
-	0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x83,
-	0x02, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x45, 0x54, 0x50, 0x49, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x50, 0x41,
+	0x55, 0x53, 0x45, 0x10, 0x03, 0x22, 0xf7, 0x03, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x72,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x70,
+	0x75, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x4e, 0x53, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a,
+	0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x12,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x6d, 0x70, 0x53, 0x74, 0x65, 0x70, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x6d, 0x70,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x61,
+	0x6d, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x6d, 0x70, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x70, 0x75, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63,
+	0x70, 0x75, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x1f,
+	0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x01, 0x22,
+	0x82, 0x02, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73,
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x70, 0x75,
 	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53,
 	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
@@ -3121,206 +3668,267 @@ var file_chaosdaemon_proto_rawDesc = []byte{
 	0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
 	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x55, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f,
-	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0xfa, 0x01,
-	0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x16, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x0a, 0x54, 0x63, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x74, 0x63, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x52, 0x03, 0x74, 0x63, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x8f, 0x02,
-	0x0a, 0x02, 0x54, 0x63, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x52,
-	0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x62, 0x66, 0x52, 0x03, 0x74, 0x62,
-	0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x20, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x54, 0x45, 0x4d, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x01, 0x22,
-	0x89, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6e,
-	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x7d, 0x0a, 0x16, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x7f, 0x0a, 0x18, 0x55, 0x6e,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x55, 0x69, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x70,
+	0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x73,
+	0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x55, 0x69, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74,
+	0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6c, 0x73,
+	0x22, 0xab, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68,
+	0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0xfa,
+	0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x72, 0x70, 0x63, 0x43, 0x68, 0x61, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x16,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x72, 0x70, 0x63, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x0a, 0x54, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x74, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x52, 0x03, 0x74, 0x63,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x8f,
+	0x02, 0x0a, 0x02, 0x54, 0x63, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x62, 0x66, 0x52, 0x03, 0x74,
+	0x62, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x20,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x54, 0x45, 0x4d, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x01,
+	0x22, 0x89, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x7d, 0x0a, 0x16,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
 	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
 	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0xf0, 0x01, 0x0a, 0x16,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44,
-	0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x13, 0x0a, 0x06, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x10, 0x00, 0x22, 0x60,
-	0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x22, 0x43, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x70,
-	0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x55, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x7f, 0x0a, 0x18, 0x55,
+	0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0xf0, 0x01, 0x0a,
+	0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x13, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x10, 0x00, 0x22,
+	0x60, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x22, 0x43, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x55, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x32, 0xd2, 0x08, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x44, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x54, 0x63, 0x73, 0x12, 0x0e, 0x2e, 0x70,
-	0x62, 0x2e, 0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x49,
-	0x50, 0x53, 0x65, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x74,
+	0x6e, 0x49, 0x64, 0x32, 0xeb, 0x0a, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x44, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x54, 0x63, 0x73, 0x12, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x49, 0x50, 0x53, 0x65, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x53, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x70,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0d, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x69, 0x64, 0x12, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x55, 0x6e, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x78,
+	0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12,
+	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x70, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d,
-	0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61,
+	0x6f, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48,
+	0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x72, 0x70, 0x63, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47,
+	0x72, 0x70, 0x63, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x72, 0x70, 0x63, 0x43, 0x68,
+	0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x69, 0x64, 0x12, 0x14, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x45,
-	0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70,
-	0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a,
-	0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73,
-	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68,
-	0x61, 0x6f, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f,
-	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
-	0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41,
-	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44,
+	0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x55, 0x6e,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
-	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56,
-	0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3336,7 +3944,7 @@ func file_chaosdaemon_proto_rawDescGZIP() []byte {
 }
 
 var file_chaosdaemon_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_chaosdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_chaosdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_chaosdaemon_proto_goTypes = []interface{}{
 	(Chain_Direction)(0),               // 0: pb.Chain.Direction
 	(ContainerAction_Action)(0),        // 1: pb.ContainerAction.Action
@@ -3346,107 +3954,123 @@ var file_chaosdaemon_proto_goTypes = []interface{}{
 	(*TcHandle)(nil),                   // 5: pb.TcHandle
 	(*ContainerRequest)(nil),           // 6: pb.ContainerRequest
 	(*ContainerResponse)(nil),          // 7: pb.ContainerResponse
-	(*NetemRequest)(nil),               // 8: pb.NetemRequest
-	(*Netem)(nil),                      // 9: pb.Netem
-	(*TbfRequest)(nil),                 // 10: pb.TbfRequest
-	(*Tbf)(nil),                        // 11: pb.Tbf
-	(*QdiscRequest)(nil),               // 12: pb.QdiscRequest
-	(*Qdisc)(nil),                      // 13: pb.Qdisc
-	(*EmatchFilterRequest)(nil),        // 14: pb.EmatchFilterRequest
-	(*EmatchFilter)(nil),               // 15: pb.EmatchFilter
-	(*TcFilterRequest)(nil),            // 16: pb.TcFilterRequest
-	(*TcFilter)(nil),                   // 17: pb.TcFilter
-	(*IPSetsRequest)(nil),              // 18: pb.IPSetsRequest
-	(*IPSet)(nil),                      // 19: pb.IPSet
-	(*CidrAndPort)(nil),                // 20: pb.CidrAndPort
-	(*IptablesChainsRequest)(nil),      // 21: pb.IptablesChainsRequest
-	(*Chain)(nil),                      // 22: pb.Chain
-	(*TimeRequest)(nil),                // 23: pb.TimeRequest
-	(*ContainerAction)(nil),            // 24: pb.ContainerAction
-	(*ExecStressRequest)(nil),          // 25: pb.ExecStressRequest
-	(*ExecStressResponse)(nil),         // 26: pb.ExecStressResponse
-	(*CancelStressRequest)(nil),        // 27: pb.CancelStressRequest
-	(*ApplyIOChaosRequest)(nil),        // 28: pb.ApplyIOChaosRequest
-	(*ApplyIOChaosResponse)(nil),       // 29: pb.ApplyIOChaosResponse
-	(*ApplyHttpChaosRequest)(nil),      // 30: pb.ApplyHttpChaosRequest
-	(*ApplyHttpChaosResponse)(nil),     // 31: pb.ApplyHttpChaosResponse
-	(*TcsRequest)(nil),                 // 32: pb.TcsRequest
-	(*Tc)(nil),                         // 33: pb.Tc
-	(*SetDNSServerRequest)(nil),        // 34: pb.SetDNSServerRequest
-	(*InstallJVMRulesRequest)(nil),     // 35: pb.InstallJVMRulesRequest
-	(*UninstallJVMRulesRequest)(nil),   // 36: pb.UninstallJVMRulesRequest
-	(*ApplyBlockChaosRequest)(nil),     // 37: pb.ApplyBlockChaosRequest
-	(*BlockDelaySpec)(nil),             // 38: pb.BlockDelaySpec
-	(*BlockLimitSpec)(nil),             // 39: pb.BlockLimitSpec
-	(*ApplyBlockChaosResponse)(nil),    // 40: pb.ApplyBlockChaosResponse
-	(*RecoverBlockChaosRequest)(nil),   // 41: pb.RecoverBlockChaosRequest
-	(*empty.Empty)(nil),                // 42: google.protobuf.Empty
+	(*KillProcessesRequest)(nil),       // 8: pb.KillProcessesRequest
+	(*KilledProcess)(nil),              // 9: pb.KilledProcess
+	(*KillProcessesResponse)(nil),      // 10: pb.KillProcessesResponse
+	(*NetemRequest)(nil),               // 11: pb.NetemRequest
+	(*Netem)(nil),                      // 12: pb.Netem
+	(*LossGEModel)(nil),                // 13: pb.LossGEModel
+	(*TbfRequest)(nil),                 // 14: pb.TbfRequest
+	(*Tbf)(nil),                        // 15: pb.Tbf
+	(*QdiscRequest)(nil),               // 16: pb.QdiscRequest
+	(*Qdisc)(nil),                      // 17: pb.Qdisc
+	(*EmatchFilterRequest)(nil),        // 18: pb.EmatchFilterRequest
+	(*EmatchFilter)(nil),               // 19: pb.EmatchFilter
+	(*TcFilterRequest)(nil),            // 20: pb.TcFilterRequest
+	(*TcFilter)(nil),                   // 21: pb.TcFilter
+	(*IPSetsRequest)(nil),              // 22: pb.IPSetsRequest
+	(*IPSet)(nil),                      // 23: pb.IPSet
+	(*CidrAndPort)(nil),                // 24: pb.CidrAndPort
+	(*IptablesChainsRequest)(nil),      // 25: pb.IptablesChainsRequest
+	(*Chain)(nil),                      // 26: pb.Chain
+	(*TimeRequest)(nil),                // 27: pb.TimeRequest
+	(*ContainerAction)(nil),            // 28: pb.ContainerAction
+	(*ExecStressRequest)(nil),          // 29: pb.ExecStressRequest
+	(*ExecStressResponse)(nil),         // 30: pb.ExecStressResponse
+	(*CancelStressRequest)(nil),        // 31: pb.CancelStressRequest
+	(*ApplyIOChaosRequest)(nil),        // 32: pb.ApplyIOChaosRequest
+	(*ApplyIOChaosResponse)(nil),       // 33: pb.ApplyIOChaosResponse
+	(*ApplyHttpChaosRequest)(nil),      // 34: pb.ApplyHttpChaosRequest
+	(*ApplyHttpChaosResponse)(nil),     // 35: pb.ApplyHttpChaosResponse
+	(*ApplyGrpcChaosRequest)(nil),      // 36: pb.ApplyGrpcChaosRequest
+	(*ApplyGrpcChaosResponse)(nil),     // 37: pb.ApplyGrpcChaosResponse
+	(*TcsRequest)(nil),                 // 38: pb.TcsRequest
+	(*Tc)(nil),                         // 39: pb.Tc
+	(*SetDNSServerRequest)(nil),        // 40: pb.SetDNSServerRequest
+	(*InstallJVMRulesRequest)(nil),     // 41: pb.InstallJVMRulesRequest
+	(*UninstallJVMRulesRequest)(nil),   // 42: pb.UninstallJVMRulesRequest
+	(*ApplyBlockChaosRequest)(nil),     // 43: pb.ApplyBlockChaosRequest
+	(*BlockDelaySpec)(nil),             // 44: pb.BlockDelaySpec
+	(*BlockLimitSpec)(nil),             // 45: pb.BlockLimitSpec
+	(*ApplyBlockChaosResponse)(nil),    // 46: pb.ApplyBlockChaosResponse
+	(*RecoverBlockChaosRequest)(nil),   // 47: pb.RecoverBlockChaosRequest
+	(*empty.Empty)(nil),                // 48: google.protobuf.Empty
 }
 var file_chaosdaemon_proto_depIdxs = []int32{
-	24, // 0: pb.ContainerRequest.action:type_name -> pb.ContainerAction
-	9,  // 1: pb.NetemRequest.netem:type_name -> pb.Netem
-	5,  // 2: pb.NetemRequest.handle:type_name -> pb.TcHandle
-	5,  // 3: pb.NetemRequest.parent:type_name -> pb.TcHandle
-	5,  // 4: pb.Netem.parent:type_name -> pb.TcHandle
-	5,  // 5: pb.Netem.handle:type_name -> pb.TcHandle
-	11, // 6: pb.TbfRequest.tbf:type_name -> pb.Tbf
-	13, // 7: pb.QdiscRequest.qdisc:type_name -> pb.Qdisc
-	5,  // 8: pb.Qdisc.parent:type_name -> pb.TcHandle
-	5,  // 9: pb.Qdisc.handle:type_name -> pb.TcHandle
-	15, // 10: pb.EmatchFilterRequest.filter:type_name -> pb.EmatchFilter
-	5,  // 11: pb.EmatchFilter.parent:type_name -> pb.TcHandle
-	5,  // 12: pb.EmatchFilter.classid:type_name -> pb.TcHandle
-	17, // 13: pb.TcFilterRequest.filter:type_name -> pb.TcFilter
-	5,  // 14: pb.TcFilter.parent:type_name -> pb.TcHandle
-	19, // 15: pb.IPSetsRequest.ipsets:type_name -> pb.IPSet
-	20, // 16: pb.IPSet.cidr_and_ports:type_name -> pb.CidrAndPort
-	22, // 17: pb.IptablesChainsRequest.chains:type_name -> pb.Chain
-	0,  // 18: pb.Chain.direction:type_name -> pb.Chain.Direction
-	1,  // 19: pb.ContainerAction.action:type_name -> pb.ContainerAction.Action
-	2,  // 20: pb.ExecStressRequest.scope:type_name -> pb.ExecStressRequest.Scope
-	33, // 21: pb.TcsRequest.tcs:type_name -> pb.Tc
-	3,  // 22: pb.Tc.type:type_name -> pb.Tc.Type
-	9,  // 23: pb.Tc.netem:type_name -> pb.Netem
-	11, // 24: pb.Tc.tbf:type_name -> pb.Tbf
-	4,  // 25: pb.ApplyBlockChaosRequest.action:type_name -> pb.ApplyBlockChaosRequest.Action
-	38, // 26: pb.ApplyBlockChaosRequest.delay:type_name -> pb.BlockDelaySpec
-	32, // 27: pb.ChaosDaemon.SetTcs:input_type -> pb.TcsRequest
-	18, // 28: pb.ChaosDaemon.FlushIPSets:input_type -> pb.IPSetsRequest
-	21, // 29: pb.ChaosDaemon.SetIptablesChains:input_type -> pb.IptablesChainsRequest
-	23, // 30: pb.ChaosDaemon.SetTimeOffset:input_type -> pb.TimeRequest
-	23, // 31: pb.ChaosDaemon.RecoverTimeOffset:input_type -> pb.TimeRequest
-	6,  // 32: pb.ChaosDaemon.ContainerKill:input_type -> pb.ContainerRequest
-	6,  // 33: pb.ChaosDaemon.ContainerGetPid:input_type -> pb.ContainerRequest
-	25, // 34: pb.ChaosDaemon.ExecStressors:input_type -> pb.ExecStressRequest
-	27, // 35: pb.ChaosDaemon.CancelStressors:input_type -> pb.CancelStressRequest
-	28, // 36: pb.ChaosDaemon.ApplyIOChaos:input_type -> pb.ApplyIOChaosRequest
-	30, // 37: pb.ChaosDaemon.ApplyHttpChaos:input_type -> pb.ApplyHttpChaosRequest
-	37, // 38: pb.ChaosDaemon.ApplyBlockChaos:input_type -> pb.ApplyBlockChaosRequest
-	41, // 39: pb.ChaosDaemon.RecoverBlockChaos:input_type -> pb.RecoverBlockChaosRequest
-	34, // 40: pb.ChaosDaemon.SetDNSServer:input_type -> pb.SetDNSServerRequest
-	35, // 41: pb.ChaosDaemon.InstallJVMRules:input_type -> pb.InstallJVMRulesRequest
-	36, // 42: pb.ChaosDaemon.UninstallJVMRules:input_type -> pb.UninstallJVMRulesRequest
-	42, // 43: pb.ChaosDaemon.SetTcs:output_type -> google.protobuf.Empty
-	42, // 44: pb.ChaosDaemon.FlushIPSets:output_type -> google.protobuf.Empty
-	42, // 45: pb.ChaosDaemon.SetIptablesChains:output_type -> google.protobuf.Empty
-	42, // 46: pb.ChaosDaemon.SetTimeOffset:output_type -> google.protobuf.Empty
-	42, // 47: pb.ChaosDaemon.RecoverTimeOffset:output_type -> google.protobuf.Empty
-	42, // 48: pb.ChaosDaemon.ContainerKill:output_type -> google.protobuf.Empty
-	7,  // 49: pb.ChaosDaemon.ContainerGetPid:output_type -> pb.ContainerResponse
-	26, // 50: pb.ChaosDaemon.ExecStressors:output_type -> pb.ExecStressResponse
-	42, // 51: pb.ChaosDaemon.CancelStressors:output_type -> google.protobuf.Empty
-	29, // 52: pb.ChaosDaemon.ApplyIOChaos:output_type -> pb.ApplyIOChaosResponse
-	31, // 53: pb.ChaosDaemon.ApplyHttpChaos:output_type -> pb.ApplyHttpChaosResponse
-	40, // 54: pb.ChaosDaemon.ApplyBlockChaos:output_type -> pb.ApplyBlockChaosResponse
-	42, // 55: pb.ChaosDaemon.RecoverBlockChaos:output_type -> google.protobuf.Empty
-	42, // 56: pb.ChaosDaemon.SetDNSServer:output_type -> google.protobuf.Empty
-	42, // 57: pb.ChaosDaemon.InstallJVMRules:output_type -> google.protobuf.Empty
-	42, // 58: pb.ChaosDaemon.UninstallJVMRules:output_type -> google.protobuf.Empty
-	43, // [43:59] is the sub-list for method output_type
-	27, // [27:43] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	28, // 0: pb.ContainerRequest.action:type_name -> pb.ContainerAction
+	9,  // 1: pb.KillProcessesResponse.processes:type_name -> pb.KilledProcess
+	12, // 2: pb.NetemRequest.netem:type_name -> pb.Netem
+	5,  // 3: pb.NetemRequest.handle:type_name -> pb.TcHandle
+	5,  // 4: pb.NetemRequest.parent:type_name -> pb.TcHandle
+	5,  // 5: pb.Netem.parent:type_name -> pb.TcHandle
+	5,  // 6: pb.Netem.handle:type_name -> pb.TcHandle
+	13, // 7: pb.Netem.loss_gemodel:type_name -> pb.LossGEModel
+	15, // 8: pb.TbfRequest.tbf:type_name -> pb.Tbf
+	17, // 9: pb.QdiscRequest.qdisc:type_name -> pb.Qdisc
+	5,  // 10: pb.Qdisc.parent:type_name -> pb.TcHandle
+	5,  // 11: pb.Qdisc.handle:type_name -> pb.TcHandle
+	19, // 12: pb.EmatchFilterRequest.filter:type_name -> pb.EmatchFilter
+	5,  // 13: pb.EmatchFilter.parent:type_name -> pb.TcHandle
+	5,  // 14: pb.EmatchFilter.classid:type_name -> pb.TcHandle
+	21, // 15: pb.TcFilterRequest.filter:type_name -> pb.TcFilter
+	5,  // 16: pb.TcFilter.parent:type_name -> pb.TcHandle
+	23, // 17: pb.IPSetsRequest.ipsets:type_name -> pb.IPSet
+	24, // 18: pb.IPSet.cidr_and_ports:type_name -> pb.CidrAndPort
+	26, // 19: pb.IptablesChainsRequest.chains:type_name -> pb.Chain
+	0,  // 20: pb.Chain.direction:type_name -> pb.Chain.Direction
+	1,  // 21: pb.ContainerAction.action:type_name -> pb.ContainerAction.Action
+	2,  // 22: pb.ExecStressRequest.scope:type_name -> pb.ExecStressRequest.Scope
+	39, // 23: pb.TcsRequest.tcs:type_name -> pb.Tc
+	3,  // 24: pb.Tc.type:type_name -> pb.Tc.Type
+	12, // 25: pb.Tc.netem:type_name -> pb.Netem
+	15, // 26: pb.Tc.tbf:type_name -> pb.Tbf
+	4,  // 27: pb.ApplyBlockChaosRequest.action:type_name -> pb.ApplyBlockChaosRequest.Action
+	44, // 28: pb.ApplyBlockChaosRequest.delay:type_name -> pb.BlockDelaySpec
+	38, // 29: pb.ChaosDaemon.SetTcs:input_type -> pb.TcsRequest
+	22, // 30: pb.ChaosDaemon.FlushIPSets:input_type -> pb.IPSetsRequest
+	25, // 31: pb.ChaosDaemon.SetIptablesChains:input_type -> pb.IptablesChainsRequest
+	27, // 32: pb.ChaosDaemon.SetTimeOffset:input_type -> pb.TimeRequest
+	27, // 33: pb.ChaosDaemon.RecoverTimeOffset:input_type -> pb.TimeRequest
+	6,  // 34: pb.ChaosDaemon.ContainerKill:input_type -> pb.ContainerRequest
+	6,  // 35: pb.ChaosDaemon.ContainerGetPid:input_type -> pb.ContainerRequest
+	6,  // 36: pb.ChaosDaemon.ContainerPause:input_type -> pb.ContainerRequest
+	6,  // 37: pb.ChaosDaemon.ContainerUnpause:input_type -> pb.ContainerRequest
+	8,  // 38: pb.ChaosDaemon.KillProcesses:input_type -> pb.KillProcessesRequest
+	29, // 39: pb.ChaosDaemon.ExecStressors:input_type -> pb.ExecStressRequest
+	31, // 40: pb.ChaosDaemon.CancelStressors:input_type -> pb.CancelStressRequest
+	32, // 41: pb.ChaosDaemon.ApplyIOChaos:input_type -> pb.ApplyIOChaosRequest
+	34, // 42: pb.ChaosDaemon.ApplyHttpChaos:input_type -> pb.ApplyHttpChaosRequest
+	36, // 43: pb.ChaosDaemon.ApplyGrpcChaos:input_type -> pb.ApplyGrpcChaosRequest
+	43, // 44: pb.ChaosDaemon.ApplyBlockChaos:input_type -> pb.ApplyBlockChaosRequest
+	47, // 45: pb.ChaosDaemon.RecoverBlockChaos:input_type -> pb.RecoverBlockChaosRequest
+	40, // 46: pb.ChaosDaemon.SetDNSServer:input_type -> pb.SetDNSServerRequest
+	41, // 47: pb.ChaosDaemon.InstallJVMRules:input_type -> pb.InstallJVMRulesRequest
+	42, // 48: pb.ChaosDaemon.UninstallJVMRules:input_type -> pb.UninstallJVMRulesRequest
+	48, // 49: pb.ChaosDaemon.SetTcs:output_type -> google.protobuf.Empty
+	48, // 50: pb.ChaosDaemon.FlushIPSets:output_type -> google.protobuf.Empty
+	48, // 51: pb.ChaosDaemon.SetIptablesChains:output_type -> google.protobuf.Empty
+	48, // 52: pb.ChaosDaemon.SetTimeOffset:output_type -> google.protobuf.Empty
+	48, // 53: pb.ChaosDaemon.RecoverTimeOffset:output_type -> google.protobuf.Empty
+	48, // 54: pb.ChaosDaemon.ContainerKill:output_type -> google.protobuf.Empty
+	7,  // 55: pb.ChaosDaemon.ContainerGetPid:output_type -> pb.ContainerResponse
+	48, // 56: pb.ChaosDaemon.ContainerPause:output_type -> google.protobuf.Empty
+	48, // 57: pb.ChaosDaemon.ContainerUnpause:output_type -> google.protobuf.Empty
+	10, // 58: pb.ChaosDaemon.KillProcesses:output_type -> pb.KillProcessesResponse
+	30, // 59: pb.ChaosDaemon.ExecStressors:output_type -> pb.ExecStressResponse
+	48, // 60: pb.ChaosDaemon.CancelStressors:output_type -> google.protobuf.Empty
+	33, // 61: pb.ChaosDaemon.ApplyIOChaos:output_type -> pb.ApplyIOChaosResponse
+	35, // 62: pb.ChaosDaemon.ApplyHttpChaos:output_type -> pb.ApplyHttpChaosResponse
+	37, // 63: pb.ChaosDaemon.ApplyGrpcChaos:output_type -> pb.ApplyGrpcChaosResponse
+	46, // 64: pb.ChaosDaemon.ApplyBlockChaos:output_type -> pb.ApplyBlockChaosResponse
+	48, // 65: pb.ChaosDaemon.RecoverBlockChaos:output_type -> google.protobuf.Empty
+	48, // 66: pb.ChaosDaemon.SetDNSServer:output_type -> google.protobuf.Empty
+	48, // 67: pb.ChaosDaemon.InstallJVMRules:output_type -> google.protobuf.Empty
+	48, // 68: pb.ChaosDaemon.UninstallJVMRules:output_type -> google.protobuf.Empty
+	49, // [49:69] is the sub-list for method output_type
+	29, // [29:49] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_chaosdaemon_proto_init() }
@@ -3492,7 +4116,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetemRequest); i {
+			switch v := v.(*KillProcessesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3504,7 +4128,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Netem); i {
+			switch v := v.(*KilledProcess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3516,7 +4140,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TbfRequest); i {
+			switch v := v.(*KillProcessesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3528,7 +4152,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tbf); i {
+			switch v := v.(*NetemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3540,7 +4164,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QdiscRequest); i {
+			switch v := v.(*Netem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3552,7 +4176,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Qdisc); i {
+			switch v := v.(*LossGEModel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3564,7 +4188,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmatchFilterRequest); i {
+			switch v := v.(*TbfRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3576,7 +4200,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmatchFilter); i {
+			switch v := v.(*Tbf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3588,7 +4212,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcFilterRequest); i {
+			switch v := v.(*QdiscRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3600,7 +4224,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcFilter); i {
+			switch v := v.(*Qdisc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3612,7 +4236,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPSetsRequest); i {
+			switch v := v.(*EmatchFilterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3624,7 +4248,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPSet); i {
+			switch v := v.(*EmatchFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3636,7 +4260,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CidrAndPort); i {
+			switch v := v.(*TcFilterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3648,7 +4272,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IptablesChainsRequest); i {
+			switch v := v.(*TcFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3660,7 +4284,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chain); i {
+			switch v := v.(*IPSetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3672,7 +4296,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeRequest); i {
+			switch v := v.(*IPSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3684,7 +4308,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerAction); i {
+			switch v := v.(*CidrAndPort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3696,7 +4320,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecStressRequest); i {
+			switch v := v.(*IptablesChainsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3708,7 +4332,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecStressResponse); i {
+			switch v := v.(*Chain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3720,7 +4344,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelStressRequest); i {
+			switch v := v.(*TimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3732,7 +4356,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyIOChaosRequest); i {
+			switch v := v.(*ContainerAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3744,7 +4368,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyIOChaosResponse); i {
+			switch v := v.(*ExecStressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3756,7 +4380,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyHttpChaosRequest); i {
+			switch v := v.(*ExecStressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3768,7 +4392,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyHttpChaosResponse); i {
+			switch v := v.(*CancelStressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3780,7 +4404,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcsRequest); i {
+			switch v := v.(*ApplyIOChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3792,7 +4416,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tc); i {
+			switch v := v.(*ApplyIOChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3804,7 +4428,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDNSServerRequest); i {
+			switch v := v.(*ApplyHttpChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3816,7 +4440,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallJVMRulesRequest); i {
+			switch v := v.(*ApplyHttpChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3828,7 +4452,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UninstallJVMRulesRequest); i {
+			switch v := v.(*ApplyGrpcChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3840,7 +4464,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyBlockChaosRequest); i {
+			switch v := v.(*ApplyGrpcChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3852,7 +4476,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockDelaySpec); i {
+			switch v := v.(*TcsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3864,7 +4488,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockLimitSpec); i {
+			switch v := v.(*Tc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3876,7 +4500,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyBlockChaosResponse); i {
+			switch v := v.(*SetDNSServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3888,6 +4512,78 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallJVMRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallJVMRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBlockChaosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDelaySpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockLimitSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBlockChaosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecoverBlockChaosRequest); i {
 			case 0:
 				return &v.state
@@ -3906,7 +4602,7 @@ func file_chaosdaemon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chaosdaemon_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   37,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3940,10 +4636,14 @@ type ChaosDaemonClient interface {
 	RecoverTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ContainerKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ContainerGetPid(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*ContainerResponse, error)
+	ContainerPause(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ContainerUnpause(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	KillProcesses(ctx context.Context, in *KillProcessesRequest, opts ...grpc.CallOption) (*KillProcessesResponse, error)
 	ExecStressors(ctx context.Context, in *ExecStressRequest, opts ...grpc.CallOption) (*ExecStressResponse, error)
 	CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ApplyIOChaos(ctx context.Context, in *ApplyIOChaosRequest, opts ...grpc.CallOption) (*ApplyIOChaosResponse, error)
 	ApplyHttpChaos(ctx context.Context, in *ApplyHttpChaosRequest, opts ...grpc.CallOption) (*ApplyHttpChaosResponse, error)
+	ApplyGrpcChaos(ctx context.Context, in *ApplyGrpcChaosRequest, opts ...grpc.CallOption) (*ApplyGrpcChaosResponse, error)
 	ApplyBlockChaos(ctx context.Context, in *ApplyBlockChaosRequest, opts ...grpc.CallOption) (*ApplyBlockChaosResponse, error)
 	RecoverBlockChaos(ctx context.Context, in *RecoverBlockChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetDNSServer(ctx context.Context, in *SetDNSServerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -4022,6 +4722,33 @@ func (c *chaosDaemonClient) ContainerGetPid(ctx context.Context, in *ContainerRe
 	return out, nil
 }
 
+func (c *chaosDaemonClient) ContainerPause(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ContainerPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ContainerUnpause(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ContainerUnpause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) KillProcesses(ctx context.Context, in *KillProcessesRequest, opts ...grpc.CallOption) (*KillProcessesResponse, error) {
+	out := new(KillProcessesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/KillProcesses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaosDaemonClient) ExecStressors(ctx context.Context, in *ExecStressRequest, opts ...grpc.CallOption) (*ExecStressResponse, error) {
 	out := new(ExecStressResponse)
 	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ExecStressors", in, out, opts...)
@@ -4058,6 +4785,15 @@ func (c *chaosDaemonClient) ApplyHttpChaos(ctx context.Context, in *ApplyHttpCha
 	return out, nil
 }
 
+func (c *chaosDaemonClient) ApplyGrpcChaos(ctx context.Context, in *ApplyGrpcChaosRequest, opts ...grpc.CallOption) (*ApplyGrpcChaosResponse, error) {
+	out := new(ApplyGrpcChaosResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyGrpcChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaosDaemonClient) ApplyBlockChaos(ctx context.Context, in *ApplyBlockChaosRequest, opts ...grpc.CallOption) (*ApplyBlockChaosResponse, error) {
 	out := new(ApplyBlockChaosResponse)
 	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyBlockChaos", in, out, opts...)
@@ -4112,10 +4848,14 @@ type ChaosDaemonServer interface {
 	RecoverTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error)
 	ContainerKill(context.Context, *ContainerRequest) (*empty.Empty, error)
 	ContainerGetPid(context.Context, *ContainerRequest) (*ContainerResponse, error)
+	ContainerPause(context.Context, *ContainerRequest) (*empty.Empty, error)
+	ContainerUnpause(context.Context, *ContainerRequest) (*empty.Empty, error)
+	KillProcesses(context.Context, *KillProcessesRequest) (*KillProcessesResponse, error)
 	ExecStressors(context.Context, *ExecStressRequest) (*ExecStressResponse, error)
 	CancelStressors(context.Context, *CancelStressRequest) (*empty.Empty, error)
 	ApplyIOChaos(context.Context, *ApplyIOChaosRequest) (*ApplyIOChaosResponse, error)
 	ApplyHttpChaos(context.Context, *ApplyHttpChaosRequest) (*ApplyHttpChaosResponse, error)
+	ApplyGrpcChaos(context.Context, *ApplyGrpcChaosRequest) (*ApplyGrpcChaosResponse, error)
 	ApplyBlockChaos(context.Context, *ApplyBlockChaosRequest) (*ApplyBlockChaosResponse, error)
 	RecoverBlockChaos(context.Context, *RecoverBlockChaosRequest) (*empty.Empty, error)
 	SetDNSServer(context.Context, *SetDNSServerRequest) (*empty.Empty, error)
@@ -4148,6 +4888,15 @@ func (*UnimplementedChaosDaemonServer) ContainerKill(context.Context, *Container
 func (*UnimplementedChaosDaemonServer) ContainerGetPid(context.Context, *ContainerRequest) (*ContainerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContainerGetPid not implemented")
 }
+func (*UnimplementedChaosDaemonServer) ContainerPause(context.Context, *ContainerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerPause not implemented")
+}
+func (*UnimplementedChaosDaemonServer) ContainerUnpause(context.Context, *ContainerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerUnpause not implemented")
+}
+func (*UnimplementedChaosDaemonServer) KillProcesses(context.Context, *KillProcessesRequest) (*KillProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillProcesses not implemented")
+}
 func (*UnimplementedChaosDaemonServer) ExecStressors(context.Context, *ExecStressRequest) (*ExecStressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecStressors not implemented")
 }
@@ -4160,6 +4909,9 @@ func (*UnimplementedChaosDaemonServer) ApplyIOChaos(context.Context, *ApplyIOCha
 func (*UnimplementedChaosDaemonServer) ApplyHttpChaos(context.Context, *ApplyHttpChaosRequest) (*ApplyHttpChaosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyHttpChaos not implemented")
 }
+func (*UnimplementedChaosDaemonServer) ApplyGrpcChaos(context.Context, *ApplyGrpcChaosRequest) (*ApplyGrpcChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyGrpcChaos not implemented")
+}
 func (*UnimplementedChaosDaemonServer) ApplyBlockChaos(context.Context, *ApplyBlockChaosRequest) (*ApplyBlockChaosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyBlockChaos not implemented")
 }
@@ -4306,6 +5058,60 @@ func _ChaosDaemon_ContainerGetPid_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_ContainerPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ContainerPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ContainerPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ContainerPause(ctx, req.(*ContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_ContainerUnpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ContainerUnpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ContainerUnpause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ContainerUnpause(ctx, req.(*ContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_KillProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).KillProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/KillProcesses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).KillProcesses(ctx, req.(*KillProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaosDaemon_ExecStressors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecStressRequest)
 	if err := dec(in); err != nil {
@@ -4378,6 +5184,24 @@ func _ChaosDaemon_ApplyHttpChaos_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_ApplyGrpcChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyGrpcChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ApplyGrpcChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ApplyGrpcChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ApplyGrpcChaos(ctx, req.(*ApplyGrpcChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaosDaemon_ApplyBlockChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyBlockChaosRequest)
 	if err := dec(in); err != nil {
@@ -4500,6 +5324,18 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContainerGetPid",
 			Handler:    _ChaosDaemon_ContainerGetPid_Handler,
 		},
+		{
+			MethodName: "ContainerPause",
+			Handler:    _ChaosDaemon_ContainerPause_Handler,
+		},
+		{
+			MethodName: "ContainerUnpause",
+			Handler:    _ChaosDaemon_ContainerUnpause_Handler,
+		},
+		{
+			MethodName: "KillProcesses",
+			Handler:    _ChaosDaemon_KillProcesses_Handler,
+		},
 		{
 			MethodName: "ExecStressors",
 			Handler:    _ChaosDaemon_ExecStressors_Handler,
@@ -4516,6 +5352,10 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyHttpChaos",
 			Handler:    _ChaosDaemon_ApplyHttpChaos_Handler,
 		},
+		{
+			MethodName: "ApplyGrpcChaos",
+			Handler:    _ChaosDaemon_ApplyGrpcChaos_Handler,
+		},
 		{
 			MethodName: "ApplyBlockChaos",
 			Handler:    _ChaosDaemon_ApplyBlockChaos_Handler,
@@ -0,0 +1,110 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"regexp"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+)
+
+// KillProcesses sends the signal to the processes matching the patterns in the pid namespace of the container
+func (s *DaemonServer) KillProcesses(ctx context.Context, req *pb.KillProcessesRequest) (*pb.KillProcessesResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("Kill Processes", "request", req)
+
+	if req.NamePattern == "" && req.CmdlinePattern == "" {
+		return nil, errors.New("at least one of name pattern and cmdline pattern is required")
+	}
+	var nameRegexp, cmdlineRegexp *regexp.Regexp
+	var err error
+	if req.NamePattern != "" {
+		nameRegexp, err = regexp.Compile(req.NamePattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compile name pattern %s", req.NamePattern)
+		}
+	}
+	if req.CmdlinePattern != "" {
+		cmdlineRegexp, err = regexp.Compile(req.CmdlinePattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compile cmdline pattern %s", req.CmdlinePattern)
+		}
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting pid from container")
+		return nil, err
+	}
+
+	// a container sharing the pid namespace of the host (hostPID) would expose every process of the node,
+	// including chaos-daemon itself, to the patterns
+	inSelf, err := util.IsInSelfPidNamespace(pid)
+	if err != nil {
+		log.Error(err, "error while checking pid namespace of container")
+		return nil, err
+	}
+	if inSelf {
+		return nil, errors.Errorf("container %s shares the pid namespace with chaos-daemon, killing processes by patterns is refused", req.ContainerId)
+	}
+
+	processes, err := util.ListProcessesInPidNamespace(pid)
+	if err != nil {
+		log.Error(err, "error while listing processes of container")
+		return nil, err
+	}
+
+	resp := &pb.KillProcessesResponse{}
+	for _, process := range processes {
+		if nameRegexp != nil && !nameRegexp.MatchString(process.Comm) {
+			continue
+		}
+		if cmdlineRegexp != nil && !cmdlineRegexp.MatchString(process.Cmdline) {
+			continue
+		}
+
+		log.Info("killing process", "pid", process.Pid, "command", process.Cmdline, "signal", req.Signal)
+		if err := syscall.Kill(int(process.Pid), syscall.Signal(req.Signal)); err != nil {
+			if errors.Is(err, syscall.ESRCH) {
+				// the process has already exited
+				continue
+			}
+			log.Error(err, "error while killing process", "pid", process.Pid)
+			return nil, errors.Wrapf(err, "kill process %d", process.Pid)
+		}
+
+		command := process.Cmdline
+		if command == "" {
+			command = process.Comm
+		}
+		resp.Processes = append(resp.Processes, &pb.KilledProcess{
+			Pid:     process.NSPid,
+			Command: command,
+		})
+	}
+
+	if len(resp.Processes) == 0 {
+		return nil, errors.Errorf("no process matches the patterns in container %s", req.ContainerId)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+)
+
+// selfContainerClient resolves every container to the current process, which is what chaos-daemon sees for a
+// container running with hostPID
+type selfContainerClient struct {
+	crclients.ContainerRuntimeInfoClient
+}
+
+func (selfContainerClient) GetPidFromContainerID(_ context.Context, _ string) (uint32, error) {
+	return uint32(os.Getpid()), nil
+}
+
+func TestKillProcesses(t *testing.T) {
+	g := NewWithT(t)
+
+	logger, err := log.NewDefaultZapLogger()
+	g.Expect(err).To(BeNil())
+	s := NewDaemonServerWithCRClient(selfContainerClient{}, nil, logger)
+
+	t.Run("refuse the container in the host pid namespace", func(t *testing.T) {
+		_, err := s.KillProcesses(context.TODO(), &pb.KillProcessesRequest{
+			ContainerId:    "containerd://host-pid",
+			NamePattern:    ".*",
+			CmdlinePattern: ".*",
+			Signal:         9,
+		})
+		g.Expect(err).To(MatchError("container containerd://host-pid shares the pid namespace with chaos-daemon, killing processes by patterns is refused"))
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := s.KillProcesses(context.TODO(), &pb.KillProcessesRequest{
+			ContainerId: "containerd://host-pid",
+			NamePattern: "(",
+			Signal:      9,
+		})
+		g.Expect(err).NotTo(BeNil())
+	})
+}
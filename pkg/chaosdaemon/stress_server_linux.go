@@ -17,13 +17,17 @@ package chaosdaemon
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
@@ -39,7 +43,7 @@ func (s *DaemonServer) ExecStressors(ctx context.Context,
 	cpuProc, err := s.ExecCPUStressors(ctx, req)
 	if err != nil {
 		// this error would be resolved by grpc server framework, it's the top level to print it.
-		s.rootLogger.Error(err, "exec cpu stressors", "containerID", req.Target, "cpuStressors", req.CpuStressors)
+		s.rootLogger.Error(err, "exec cpu stressors", "containerID", req.Target, "cpuStressors", req.CpuStressors, "ioStressors", req.IoStressors)
 		return nil, err
 	}
 
@@ -112,7 +116,9 @@ func (s *DaemonServer) CancelStressors(ctx context.Context,
 func (s *DaemonServer) ExecCPUStressors(ctx context.Context,
 	req *pb.ExecStressRequest) (*bpm.Process, error) {
 	log := s.getLoggerFromContext(ctx)
-	if req.CpuStressors == "" {
+	// the io, hdd and sock stressors run in the same stress-ng process with cpu stressors
+	args := strings.Fields(req.CpuStressors + " " + req.IoStressors)
+	if len(args) == 0 {
 		return nil, nil
 	}
 	pid, err := s.crClient.GetPidFromContainerID(ctx, req.Target)
@@ -120,15 +126,35 @@ func (s *DaemonServer) ExecCPUStressors(ctx context.Context,
 		return nil, err
 	}
 
+	if req.CpuQuotaPercent != 0 {
+		quota, err := cgroups.GetCPUQuotaForPID(int(pid))
+		if err != nil {
+			return nil, err
+		}
+		if quota == 0 {
+			return nil, errors.Errorf("the cpu of container %s is not limited", req.Target)
+		}
+		args = append(args, cpuQuotaArgs(quota, int(req.CpuQuotaPercent))...)
+	}
+
+	if req.HddPath != "" {
+		// write the temporary files into the filesystem of target container
+		args = append(args, "--temp-path", fmt.Sprintf("/proc/%d/root%s", pid, req.HddPath))
+	}
+
 	attachCGroup, err := cgroups.GetAttacherForPID(int(pid))
 	if err != nil {
 		return nil, err
 	}
 
-	processBuilder := bpm.DefaultProcessBuilder("stress-ng", strings.Fields(req.CpuStressors)...).
+	processBuilder := bpm.DefaultProcessBuilder("stress-ng", args...).
 		EnablePause()
 	if req.EnterNS {
 		processBuilder = processBuilder.SetNS(pid, bpm.PidNS)
+		if req.IoStressors != "" {
+			// sock stressors should send and receive data in the network of target container
+			processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+		}
 	}
 	cmd := processBuilder.Build(ctx)
 
@@ -185,7 +211,16 @@ func (s *DaemonServer) ExecMemoryStressors(ctx context.Context,
 		return nil, err
 	}
 
-	processBuilder := bpm.DefaultProcessBuilder("memStress", strings.Fields(req.MemoryStressors)...).
+	args := strings.Fields(req.MemoryStressors)
+	if req.MemoryLimitPercent != 0 {
+		limitArgs, err := memoryLimitArgs(int(pid), req)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, limitArgs...)
+	}
+
+	processBuilder := bpm.DefaultProcessBuilder("memStress", args...).
 		EnablePause()
 
 	if req.OomScoreAdj != 0 {
@@ -231,3 +266,40 @@ func (s *DaemonServer) ExecMemoryStressors(ctx context.Context,
 
 	return proc, nil
 }
+
+// cpuQuotaArgs computes the workers and load of stress-ng to consume percent of the cpu quota,
+// every worker loads one cpu at most, so the workers are rounded up and the load is shared by them
+func cpuQuotaArgs(quota float64, percent int) []string {
+	target := quota * float64(percent) / 100
+	workers := int(math.Ceil(target))
+	load := int(math.Round(target * 100 / float64(workers)))
+
+	return []string{"--cpu", strconv.Itoa(workers), "--cpu-load", strconv.Itoa(load)}
+}
+
+// memoryLimitArgs computes the size of memStress from the memory limit of the target cgroup,
+// and the growth time if the memory grows step by step
+func memoryLimitArgs(pid int, req *pb.ExecStressRequest) ([]string, error) {
+	limit, err := cgroups.GetMemoryLimitForPID(pid)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		return nil, errors.Errorf("the memory of container %s is not limited", req.Target)
+	}
+
+	size := limit * int64(req.MemoryLimitPercent) / 100
+	args := []string{"--size", fmt.Sprintf("%dB", size)}
+	if req.MemoryRampStep != "" {
+		ramp := v1alpha1.MemoryRamp{
+			Step:     req.MemoryRampStep,
+			Interval: req.MemoryRampInterval,
+		}
+		growthTime, err := ramp.GrowthTime(size)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--time", growthTime.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_cpuQuotaArgs(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Run("consume the whole quota", func(t *testing.T) {
+		g.Expect(cpuQuotaArgs(2, 100)).To(Equal([]string{"--cpu", "2", "--cpu-load", "100"}))
+	})
+
+	t.Run("share the load by workers", func(t *testing.T) {
+		g.Expect(cpuQuotaArgs(2.5, 50)).To(Equal([]string{"--cpu", "2", "--cpu-load", "63"}))
+	})
+
+	t.Run("less than one cpu", func(t *testing.T) {
+		g.Expect(cpuQuotaArgs(0.5, 80)).To(Equal([]string{"--cpu", "1", "--cpu-load", "40"}))
+	})
+}
@@ -127,3 +127,27 @@ func (p *PodHandler) Recover(id IsID) error {
 	err = p.SubProcess.Recover(sysPID)
 	return err
 }
+
+// InjectNewProcesses get the container process IsID and inject the new processes
+// of it with major injector.
+func (p *PodHandler) InjectNewProcesses(id IsID) error {
+	podPID, ok := id.(PodContainerName)
+	if !ok {
+		return ErrNotPodContainerName.WrapInput(id).Err()
+	}
+	if p.PodProcessMap == nil {
+		return ErrPodProcessMapNotInit
+	}
+
+	sub, ok := p.SubProcess.(NewProcessInjectable)
+	if !ok {
+		return cerr.NotImpl[NewProcessInjectable]().WrapInput(p.SubProcess).Err()
+	}
+
+	sysPID, err := p.PodProcessMap.Read(podPID)
+	if err != nil {
+		return err
+	}
+
+	return sub.InjectNewProcesses(sysPID)
+}
@@ -44,22 +44,33 @@ type ChaosOnProcessGroup interface {
 	Recoverable
 }
 
+// NewProcessInjectable introduce the ability to inject the processes
+// created after the first injection.
+type NewProcessInjectable interface {
+	InjectNewProcesses(pid IsID) error
+}
+
 // ProcessGroupHandler implements injecting & recovering on a linux process group.
 type ProcessGroupHandler struct {
 	LeaderProcess ChaosOnProcessGroup
 	childMap      map[IsID]ChaosOnProcessGroup
-	Logger        logr.Logger
+	// execIdentities records the program executed by the injected child processes,
+	// so the child processes which execute another program after injection could be injected again.
+	execIdentities map[IsID]string
+	Logger         logr.Logger
 }
 
 func NewProcessGroupHandler(logger logr.Logger, leader ChaosOnProcessGroup) ProcessGroupHandler {
 	return ProcessGroupHandler{
-		LeaderProcess: leader,
-		childMap:      make(map[IsID]ChaosOnProcessGroup),
-		Logger:        logr.New(logger.GetSink()),
+		LeaderProcess:  leader,
+		childMap:       make(map[IsID]ChaosOnProcessGroup),
+		execIdentities: make(map[IsID]string),
+		Logger:         logr.New(logger.GetSink()),
 	}
 }
 
-// Inject try to inject the leader process and then try to inject child process.
+// Inject try to inject the leader process and then try to inject child process,
+// the processes injected by InjectNewProcesses are updated too.
 // If something wrong in injecting a child process, Inject will just log error & continue.
 func (gp *ProcessGroupHandler) Inject(pid IsID) error {
 	sysPID, ok := pid.(SysPID)
@@ -77,8 +88,10 @@ func (gp *ProcessGroupHandler) Inject(pid IsID) error {
 		return cerr.NotFound("child process").WrapErr(err).Err()
 	}
 
+	injected := make(map[IsID]struct{}, len(childPIDs))
 	for _, childPID := range childPIDs {
 		childSysPID := SysPID(childPID)
+		injected[childSysPID] = struct{}{}
 		if childProcessChaos, ok := gp.childMap[childSysPID]; ok {
 			err := gp.LeaderProcess.Assign(childProcessChaos)
 			if err != nil {
@@ -88,7 +101,9 @@ func (gp *ProcessGroupHandler) Inject(pid IsID) error {
 			err = childProcessChaos.Inject(childSysPID)
 			if err != nil {
 				gp.Logger.Error(err, "failed to inject old child process")
+				continue
 			}
+			gp.recordExecIdentity(childSysPID)
 		} else {
 			childProcessChaos, err := gp.LeaderProcess.Fork()
 			if err != nil {
@@ -101,6 +116,23 @@ func (gp *ProcessGroupHandler) Inject(pid IsID) error {
 				continue
 			}
 			gp.childMap[childSysPID] = childProcessChaos
+			gp.recordExecIdentity(childSysPID)
+		}
+	}
+
+	// update the processes injected by InjectNewProcesses, which may not be the child processes
+	for childID, childProcessChaos := range gp.childMap {
+		if _, ok := injected[childID]; ok {
+			continue
+		}
+		err := gp.LeaderProcess.Assign(childProcessChaos)
+		if err != nil {
+			gp.Logger.Error(err, "failed to assign old process")
+			continue
+		}
+		err = childProcessChaos.Inject(childID)
+		if err != nil {
+			gp.Logger.Error(err, "failed to inject old process")
 		}
 	}
 	return nil
@@ -131,3 +163,92 @@ func (gp *ProcessGroupHandler) Recover(pid IsID) error {
 	}
 	return nil
 }
+
+// InjectNewProcesses injects the processes in the pid namespace of the leader process,
+// which have not been injected yet, and forgets the exited ones.
+// The injected processes which have executed another program are injected again, as the injection is lost by exec.
+// If the leader process shares the pid namespace with chaos-daemon (e.g. hostPID pods),
+// only the child processes of the leader process are injected.
+// If something wrong in injecting a process, InjectNewProcesses will just log error & continue.
+func (gp *ProcessGroupHandler) InjectNewProcesses(pid IsID) error {
+	sysPID, ok := pid.(SysPID)
+	if !ok {
+		return ErrNotTypeSysID.WrapInput(pid).Err()
+	}
+
+	pids, err := gp.listGroupProcesses(uint32(sysPID))
+	if err != nil {
+		return cerr.NotFound("process group").WrapErr(err).Err()
+	}
+
+	alive := make(map[IsID]struct{}, len(pids))
+	for _, childPID := range pids {
+		if childPID == uint32(sysPID) {
+			continue
+		}
+		childSysPID := SysPID(childPID)
+		execIdentity, err := util.ReadExecIdentity(childPID)
+		if err != nil {
+			// the process has exited after listing
+			gp.Logger.V(4).Info("failed to read exec identity of process", "pid", childSysPID, "error", err)
+			continue
+		}
+		alive[childSysPID] = struct{}{}
+		if _, ok := gp.childMap[childSysPID]; ok {
+			if gp.execIdentities[childSysPID] == execIdentity {
+				continue
+			}
+			gp.Logger.Info("process has executed another program, inject it again", "pid", childSysPID)
+		}
+
+		childProcessChaos, err := gp.LeaderProcess.Fork()
+		if err != nil {
+			gp.Logger.Error(err, "failed to create new process")
+			continue
+		}
+		err = childProcessChaos.Inject(childSysPID)
+		if err != nil {
+			gp.Logger.Error(err, "failed to inject new process", "pid", childSysPID)
+			continue
+		}
+		gp.childMap[childSysPID] = childProcessChaos
+		gp.execIdentities[childSysPID] = execIdentity
+	}
+
+	for childID := range gp.childMap {
+		if _, ok := alive[childID]; !ok {
+			delete(gp.childMap, childID)
+			delete(gp.execIdentities, childID)
+		}
+	}
+	return nil
+}
+
+func (gp *ProcessGroupHandler) recordExecIdentity(pid SysPID) {
+	execIdentity, err := util.ReadExecIdentity(uint32(pid))
+	if err != nil {
+		gp.Logger.Error(err, "failed to read exec identity of process", "pid", pid)
+		return
+	}
+	gp.execIdentities[pid] = execIdentity
+}
+
+func (gp *ProcessGroupHandler) listGroupProcesses(pid uint32) ([]uint32, error) {
+	inSelf, err := util.IsInSelfPidNamespace(pid)
+	if err != nil {
+		return nil, err
+	}
+	if inSelf {
+		return util.GetChildProcesses(pid, gp.Logger)
+	}
+
+	processes, err := util.ListProcessesInPidNamespace(pid)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]uint32, 0, len(processes))
+	for _, process := range processes {
+		pids = append(pids, process.Pid)
+	}
+	return pids, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tasks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// DefaultProcessWatchInterval is the default interval to look for new processes
+const DefaultProcessWatchInterval = time.Second
+
+// ProcessWatcher calls the inject function of the watched IsIDs periodically,
+// so the processes created after the injection could be injected until recovery.
+// As the processes are polled, a new process is not affected until the next tick,
+// and the processes which exit within one interval may never be injected.
+type ProcessWatcher struct {
+	interval time.Duration
+	logger   logr.Logger
+
+	lock    sync.Mutex
+	cancels map[IsID]context.CancelFunc
+}
+
+func NewProcessWatcher(interval time.Duration, logger logr.Logger) *ProcessWatcher {
+	return &ProcessWatcher{
+		interval: interval,
+		logger:   logger,
+		cancels:  make(map[IsID]context.CancelFunc),
+	}
+}
+
+// Watch starts to call inject on id periodically until Unwatch.
+// Watching an IsID which is being watched does nothing.
+func (w *ProcessWatcher) Watch(id IsID, inject func() error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if _, ok := w.cancels[id]; ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancels[id] = cancel
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := inject(); err != nil {
+					w.logger.Error(err, "failed to inject new processes", "id", id)
+				}
+			}
+		}
+	}()
+}
+
+// Unwatch stops watching id.
+func (w *ProcessWatcher) Unwatch(id IsID) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if cancel, ok := w.cancels[id]; ok {
+		cancel()
+		delete(w.cancels, id)
+	}
+}
+
+// IsWatching returns whether id is being watched.
+func (w *ProcessWatcher) IsWatching(id IsID) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, ok := w.cancels[id]
+	return ok
+}
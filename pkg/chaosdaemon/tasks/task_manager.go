@@ -266,6 +266,21 @@ func (cm TaskManager) commit(uid TaskID, pid IsID) error {
 	return nil
 }
 
+// InjectNewProcesses injects the processes created after the task on IsID is injected.
+// The task must implement NewProcessInjectable,
+// or InjectNewProcesses will return a ErrNotImplement("NewProcessInjectable") error.
+func (cm TaskManager) InjectNewProcesses(pid IsID) error {
+	process, ok := cm.taskMap[pid]
+	if !ok {
+		return ErrNotFoundID.WrapInput(pid).Err()
+	}
+	injector, ok := process.(NewProcessInjectable)
+	if !ok {
+		return cerr.NotImpl[NewProcessInjectable]().WrapInput(process).Err()
+	}
+	return injector.InjectNewProcesses(pid)
+}
+
 // ClearTask clear the task totally.
 // IMPORTANT: Developer should only use this function
 // when want to force clear task with ignoreRecoverErr==true.
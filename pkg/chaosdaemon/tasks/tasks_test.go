@@ -17,7 +17,11 @@ package tasks
 
 import (
 	"fmt"
+	"os"
+	"os/exec"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
@@ -26,6 +30,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/cerr"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
 )
 
 type FakeConfig struct {
@@ -167,3 +172,138 @@ func TestTasksManagerError(t *testing.T) {
 	err = m.Recover(uid1, SysPID(1))
 	assert.NoError(t, err)
 }
+
+type FakeGroupChaos struct {
+	injected map[IsID]int
+}
+
+func (f *FakeGroupChaos) Fork() (ChaosOnProcessGroup, error) {
+	return f, nil
+}
+
+func (f *FakeGroupChaos) Assign(c Injectable) error {
+	return nil
+}
+
+func (f *FakeGroupChaos) Inject(pid IsID) error {
+	f.injected[pid]++
+	return nil
+}
+
+func (f *FakeGroupChaos) Recover(pid IsID) error {
+	delete(f.injected, pid)
+	return nil
+}
+
+func TestProcessGroupHandlerInjectNewProcesses(t *testing.T) {
+	zapLog, err := zap.NewDevelopment()
+	assert.NoError(t, err)
+	log := zapr.NewLogger(zapLog)
+
+	chaos := &FakeGroupChaos{injected: make(map[IsID]int)}
+	handler := NewProcessGroupHandler(log, chaos)
+
+	leader := SysPID(os.Getpid())
+	err = handler.Inject(leader)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, chaos.injected[leader])
+
+	// the process started after injection
+	cmd := exec.Command("sleep", "60")
+	assert.NoError(t, cmd.Start())
+	child := SysPID(cmd.Process.Pid)
+
+	err = handler.InjectNewProcesses(leader)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, chaos.injected[child])
+	assert.Equal(t, 1, chaos.injected[leader])
+
+	// the injected process should not be injected again
+	err = handler.InjectNewProcesses(leader)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, chaos.injected[child])
+
+	// the exited process should be forgotten
+	assert.NoError(t, cmd.Process.Kill())
+	_ = cmd.Wait()
+	err = handler.InjectNewProcesses(leader)
+	assert.NoError(t, err)
+	assert.NotContains(t, handler.childMap, child)
+	assert.NotContains(t, handler.execIdentities, child)
+}
+
+func TestProcessGroupHandlerInjectExecutedProcesses(t *testing.T) {
+	zapLog, err := zap.NewDevelopment()
+	assert.NoError(t, err)
+	log := zapr.NewLogger(zapLog)
+
+	chaos := &FakeGroupChaos{injected: make(map[IsID]int)}
+	handler := NewProcessGroupHandler(log, chaos)
+
+	leader := SysPID(os.Getpid())
+	err = handler.Inject(leader)
+	assert.NoError(t, err)
+
+	// the shell executes sleep in the same process after reading a line
+	cmd := exec.Command("sh", "-c", "read line; exec sleep 60")
+	stdin, err := cmd.StdinPipe()
+	assert.NoError(t, err)
+	assert.NoError(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+	child := SysPID(cmd.Process.Pid)
+
+	err = handler.InjectNewProcesses(leader)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, chaos.injected[child])
+
+	_, err = stdin.Write([]byte("\n"))
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		comm, _ := util.ReadCommName(int(child))
+		return comm == "sleep\n"
+	}, time.Second, 10*time.Millisecond)
+
+	// the injection is lost by exec, so the process should be injected again
+	err = handler.InjectNewProcesses(leader)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, chaos.injected[child])
+
+	err = handler.InjectNewProcesses(leader)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, chaos.injected[child])
+}
+
+func TestProcessWatcher(t *testing.T) {
+	zapLog, err := zap.NewDevelopment()
+	assert.NoError(t, err)
+	log := zapr.NewLogger(zapLog)
+
+	w := NewProcessWatcher(10*time.Millisecond, log)
+
+	var count int32
+	w.Watch(SysPID(1), func() error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	})
+	// watching an IsID twice does nothing
+	w.Watch(SysPID(1), func() error {
+		atomic.AddInt32(&count, 100)
+		return nil
+	})
+	assert.True(t, w.IsWatching(SysPID(1)))
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&count) >= 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Less(t, atomic.LoadInt32(&count), int32(100))
+
+	w.Unwatch(SysPID(1))
+	assert.False(t, w.IsWatching(SysPID(1)))
+
+	stopped := atomic.LoadInt32(&count)
+	time.Sleep(50 * time.Millisecond)
+	assert.LessOrEqual(t, atomic.LoadInt32(&count), stopped+1)
+}
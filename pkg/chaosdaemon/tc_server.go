@@ -20,6 +20,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -38,6 +41,9 @@ const (
 	ruleNotExistLowerVersion = "RTNETLINK answers: No such file or directory"
 
 	defaultDevice = "eth0"
+
+	customDistribution = "custom"
+	tcLibDirEnv        = "TC_LIB_DIR"
 )
 
 func generateQdiscArgs(action string, qdisc *pb.Qdisc) ([]string, error) {
@@ -394,6 +400,17 @@ func (c *tcClient) addNetem(device string, parent string, handle string, netem *
 	if c.enterNS {
 		processBuilder = processBuilder.SetNS(c.pid, bpm.NetNS)
 	}
+
+	if netem.Distribution == customDistribution {
+		// tc loads the distribution table from "$TC_LIB_DIR/<distribution>.dist"
+		libDir, err := writeDistributionTable(netem.DistributionTable)
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(libDir)
+
+		processBuilder = processBuilder.SetEnv(pathEnv, os.Getenv(pathEnv)).SetEnv(tcLibDirEnv, libDir)
+	}
 	cmd := processBuilder.Build(c.ctx)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -418,6 +435,33 @@ func (c *tcClient) addTbf(device string, parent string, handle string, tbf *pb.T
 	return nil
 }
 
+// writeDistributionTable writes the table into a temporary directory in the format of iproute2 ".dist" file,
+// and returns the directory
+func writeDistributionTable(table []int32) (string, error) {
+	libDir, err := os.MkdirTemp("", "chaos-mesh-tc-")
+	if err != nil {
+		return "", errors.Wrap(err, "create directory for distribution table")
+	}
+
+	var content strings.Builder
+	for i, value := range table {
+		content.WriteString(strconv.Itoa(int(value)))
+		if (i+1)%8 == 0 {
+			content.WriteString("\n")
+		} else {
+			content.WriteString(" ")
+		}
+	}
+
+	err = os.WriteFile(filepath.Join(libDir, customDistribution+".dist"), []byte(content.String()), 0644)
+	if err != nil {
+		os.RemoveAll(libDir)
+		return "", errors.Wrap(err, "write distribution table")
+	}
+
+	return libDir, nil
+}
+
 func convertNetemToArgs(netem *pb.Netem) string {
 	args := ""
 	if netem.Time > 0 {
@@ -428,6 +472,11 @@ func convertNetemToArgs(netem *pb.Netem) string {
 			if netem.DelayCorr > 0 {
 				args = fmt.Sprintf("%s %f", args, netem.DelayCorr)
 			}
+
+			// distribution not possible without specifying some jitter
+			if netem.Distribution != "" {
+				args = fmt.Sprintf("%s distribution %s", args, netem.Distribution)
+			}
 		}
 
 		// reordering not possible without specifying some delay
@@ -447,7 +496,11 @@ func convertNetemToArgs(netem *pb.Netem) string {
 		args = fmt.Sprintf("%s limit %d", args, netem.Limit)
 	}
 
-	if netem.Loss > 0 {
+	// netem only accepts one loss model, and the gemodel takes precedence
+	if netem.LossGemodel != nil {
+		geModel := netem.LossGemodel
+		args = fmt.Sprintf("%s loss gemodel %f %f %f %f", args, geModel.P, geModel.R, geModel.BadLoss, geModel.GoodLoss)
+	} else if netem.Loss > 0 {
 		args = fmt.Sprintf("%s loss %f", args, netem.Loss)
 		if netem.LossCorr > 0 {
 			args = fmt.Sprintf("%s %f", args, netem.LossCorr)
@@ -503,7 +556,7 @@ func abstractTcFilter(tc *pb.Tc) string {
 	}
 
 	if len(tc.SourcePort) > 0 {
-		filter += "-" + tc.EgressPort
+		filter += "-" + tc.SourcePort
 	}
 
 	return filter
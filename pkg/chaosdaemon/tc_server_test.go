@@ -77,6 +77,30 @@ func Test_convertNetemToArgs(t *testing.T) {
 		g.Expect(args).To(Equal("delay 1000 10000 25.000000"))
 	})
 
+	t.Run("convert network delay distribution", func(t *testing.T) {
+		args := convertNetemToArgs(&pb.Netem{
+			Time:         1000,
+			Distribution: "pareto",
+		})
+		g.Expect(args).To(Equal("delay 1000"))
+
+		args = convertNetemToArgs(&pb.Netem{
+			Time:         1000,
+			Jitter:       10000,
+			Distribution: "pareto",
+		})
+		g.Expect(args).To(Equal("delay 1000 10000 distribution pareto"))
+
+		args = convertNetemToArgs(&pb.Netem{
+			Time:              1000,
+			Jitter:            10000,
+			DelayCorr:         25,
+			Distribution:      "custom",
+			DistributionTable: []int32{-8192, 0, 8192},
+		})
+		g.Expect(args).To(Equal("delay 1000 10000 25.000000 distribution custom"))
+	})
+
 	t.Run("convert packet limit", func(t *testing.T) {
 		args := convertNetemToArgs(&pb.Netem{
 			Limit: 1000,
@@ -95,6 +119,16 @@ func Test_convertNetemToArgs(t *testing.T) {
 			LossCorr: 12,
 		})
 		g.Expect(args).To(Equal("loss 50.000000 12.000000"))
+
+		args = convertNetemToArgs(&pb.Netem{
+			Loss: 50,
+			LossGemodel: &pb.LossGEModel{
+				P:       1,
+				R:       10,
+				BadLoss: 100,
+			},
+		})
+		g.Expect(args).To(Equal("loss gemodel 1.000000 10.000000 100.000000 0.000000"))
 	})
 
 	t.Run("convert packet reorder", func(t *testing.T) {
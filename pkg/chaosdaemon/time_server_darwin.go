@@ -30,6 +30,8 @@ import (
 type TimeChaosServer struct {
 	podContainerNameProcessMap tasks.PodContainerNameProcessMap
 	manager                    tasks.TaskManager
+	// watcher injects the processes created after the injection
+	watcher *tasks.ProcessWatcher
 
 	nameLocker tasks.LockMap[tasks.PodContainerName]
 	logger     logr.Logger
@@ -21,6 +21,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/cerr"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
@@ -31,6 +32,8 @@ import (
 type TimeChaosServer struct {
 	podContainerNameProcessMap tasks.PodContainerNameProcessMap
 	manager                    tasks.TaskManager
+	// watcher injects the processes created after the injection
+	watcher *tasks.ProcessWatcher
 
 	nameLocker tasks.LockMap[tasks.PodContainerName]
 	logger     logr.Logger
@@ -69,6 +72,17 @@ func (s *TimeChaosServer) SetTimeOffset(uid tasks.TaskID, id tasks.PodContainerN
 			return err
 		}
 	}
+
+	s.watcher.Watch(id, func() error {
+		unlock := s.nameLocker.Lock(id)
+		defer unlock()
+
+		// the task may be recovered before the watcher is stopped
+		if len(s.manager.GetUIDsWithPID(id)) == 0 {
+			return nil
+		}
+		return s.manager.InjectNewProcesses(id)
+	})
 	return nil
 }
 
@@ -83,9 +97,22 @@ func (s *DaemonServer) SetTimeOffset(ctx context.Context, req *pb.TimeRequest) (
 		return nil, err
 	}
 
+	var driftStart int64
+	if req.DriftRate != 0 {
+		// the drift starts from now, and CLOCK_MONOTONIC is used to measure the
+		// elapsed time in the fake images
+		var now unix.Timespec
+		err = unix.ClockGettime(unix.CLOCK_MONOTONIC, &now)
+		if err != nil {
+			logger.Error(err, "error while getting monotonic time")
+			return nil, err
+		}
+		driftStart = now.Nano()
+	}
+
 	s.timeChaosServer.SetPodContainerNameProcess(tasks.PodContainerName(req.PodContainerName), tasks.SysPID(pid))
 	err = s.timeChaosServer.SetTimeOffset(req.Uid, tasks.PodContainerName(req.PodContainerName),
-		time.NewConfig(req.Sec, req.Nsec, req.ClkIdsMask))
+		time.NewConfigWithDrift(req.Sec, req.Nsec, req.ClkIdsMask, req.DriftRate, driftStart))
 	if err != nil {
 		logger.Error(err, "error while applying chaos")
 		return nil, err
@@ -118,6 +145,7 @@ func (s *DaemonServer) RecoverTimeOffset(ctx context.Context, req *pb.TimeReques
 	}
 
 	if len(s.timeChaosServer.manager.GetUIDsWithPID(nameID)) == 0 {
+		s.timeChaosServer.watcher.Unwatch(nameID)
 		s.timeChaosServer.DelPodContainerNameProcess(nameID)
 		s.timeChaosServer.nameLocker.Del(nameID)
 	}
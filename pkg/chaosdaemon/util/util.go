@@ -20,6 +20,7 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/go-logr/logr"
@@ -114,6 +115,114 @@ func GetChildProcesses(ppid uint32, logger logr.Logger) ([]uint32, error) {
 	}
 }
 
+// Process is a process read from procfs
+type Process struct {
+	// Pid is the pid of the process in the pid namespace of chaos-daemon
+	Pid uint32
+	// NSPid is the pid of the process in its innermost pid namespace
+	NSPid   uint32
+	Comm    string
+	Cmdline string
+}
+
+// ListProcessesInPidNamespace returns all processes which are in the same pid namespace with the target process.
+// The processes exited during listing are ignored.
+func ListProcessesInPidNamespace(pid uint32) ([]Process, error) {
+	targetNS, err := os.Readlink(bpm.GetNsPath(pid, bpm.PidNS))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read pid namespace of process %d", pid)
+	}
+
+	procs, err := os.ReadDir(bpm.DefaultProcPrefix)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s", bpm.DefaultProcPrefix)
+	}
+
+	var processes []Process
+	for _, proc := range procs {
+		procPid, err := strconv.ParseUint(proc.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		ns, err := os.Readlink(bpm.GetNsPath(uint32(procPid), bpm.PidNS))
+		if err != nil || ns != targetNS {
+			continue
+		}
+
+		comm, err := ReadCommName(int(procPid))
+		if err != nil {
+			continue
+		}
+		cmdline, err := os.ReadFile(fmt.Sprintf("%s/%d/cmdline", bpm.DefaultProcPrefix, procPid))
+		if err != nil {
+			continue
+		}
+
+		processes = append(processes, Process{
+			Pid:     uint32(procPid),
+			NSPid:   readNSPid(uint32(procPid)),
+			Comm:    strings.TrimSpace(comm),
+			Cmdline: strings.TrimSpace(strings.ReplaceAll(string(cmdline), "\x00", " ")),
+		})
+	}
+
+	return processes, nil
+}
+
+// IsInSelfPidNamespace returns whether the process is in the same pid namespace as the current process
+func IsInSelfPidNamespace(pid uint32) (bool, error) {
+	targetNS, err := os.Readlink(bpm.GetNsPath(pid, bpm.PidNS))
+	if err != nil {
+		return false, errors.Wrapf(err, "read pid namespace of process %d", pid)
+	}
+
+	selfNSPath := fmt.Sprintf("%s/self/ns/%s", bpm.DefaultProcPrefix, bpm.PidNS)
+	selfNS, err := os.Readlink(selfNSPath)
+	if err != nil {
+		return false, errors.Wrapf(err, "read %s", selfNSPath)
+	}
+
+	return targetNS == selfNS, nil
+}
+
+// ReadExecIdentity returns the auxiliary vector of the process, which identifies the program executed by the process.
+// It changes once the process executes another program, as the addresses in it (like the stack and vdso) are randomized
+// by ASLR for every execution.
+func ReadExecIdentity(pid uint32) (string, error) {
+	auxv, err := os.ReadFile(fmt.Sprintf("%s/%d/auxv", bpm.DefaultProcPrefix, pid))
+	if err != nil {
+		return "", errors.Wrapf(err, "read auxv of process %d", pid)
+	}
+	return string(auxv), nil
+}
+
+// readNSPid reads the pid of process in its innermost pid namespace from the NSpid field of /proc/pid/status,
+// and falls back to the pid itself if the field is not available
+func readNSPid(pid uint32) uint32 {
+	status, err := os.ReadFile(fmt.Sprintf("%s/%d/status", bpm.DefaultProcPrefix, pid))
+	if err != nil {
+		return pid
+	}
+
+	for _, line := range strings.Split(string(status), "\n") {
+		if !strings.HasPrefix(line, "NSpid:") {
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(line, "NSpid:"))
+		if len(fields) == 0 {
+			break
+		}
+		nsPid, err := strconv.ParseUint(fields[len(fields)-1], 10, 32)
+		if err != nil {
+			break
+		}
+		return uint32(nsPid)
+	}
+	return pid
+}
+
 func EncodeOutputToError(output []byte, err error) error {
 	return errors.Errorf("error code: %v, msg: %s", err, string(output))
 }
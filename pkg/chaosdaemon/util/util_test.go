@@ -0,0 +1,94 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package util
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestListProcessesInPidNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := exec.Command("sleep", "60")
+	g.Expect(cmd.Start()).To(Succeed())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	processes, err := ListProcessesInPidNamespace(uint32(os.Getpid()))
+	g.Expect(err).To(BeNil())
+
+	var found *Process
+	for i := range processes {
+		if processes[i].Pid == uint32(cmd.Process.Pid) {
+			found = &processes[i]
+		}
+	}
+	g.Expect(found).NotTo(BeNil())
+	g.Expect(found.Comm).To(Equal("sleep"))
+	g.Expect(found.Cmdline).To(Equal("sleep 60"))
+	g.Expect(found.NSPid).To(Equal(uint32(cmd.Process.Pid)))
+}
+
+func TestIsInSelfPidNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	inSelf, err := IsInSelfPidNamespace(uint32(os.Getpid()))
+	g.Expect(err).To(BeNil())
+	g.Expect(inSelf).To(BeTrue())
+
+	_, err = IsInSelfPidNamespace(0)
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestReadExecIdentity(t *testing.T) {
+	g := NewWithT(t)
+
+	// the shell waits for a line and then executes sleep in the same process
+	cmd := exec.Command("sh", "-c", "read line; exec sleep 60")
+	stdin, err := cmd.StdinPipe()
+	g.Expect(err).To(BeNil())
+	g.Expect(cmd.Start()).To(Succeed())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+	pid := uint32(cmd.Process.Pid)
+
+	before, err := ReadExecIdentity(pid)
+	g.Expect(err).To(BeNil())
+	g.Expect(before).NotTo(BeEmpty())
+
+	again, err := ReadExecIdentity(pid)
+	g.Expect(err).To(BeNil())
+	g.Expect(again).To(Equal(before))
+
+	_, err = stdin.Write([]byte("\n"))
+	g.Expect(err).To(BeNil())
+	g.Eventually(func() string {
+		comm, _ := ReadCommName(int(pid))
+		return comm
+	}).Should(Equal("sleep\n"))
+
+	after, err := ReadExecIdentity(pid)
+	g.Expect(err).To(BeNil())
+	g.Expect(after).NotTo(Equal(before))
+}
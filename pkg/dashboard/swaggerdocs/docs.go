@@ -4882,6 +4882,17 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.Argument": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.AttrOverrideSpec": {
             "type": "object",
             "properties": {
@@ -5077,6 +5088,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "quotaPercent": {
+                    "description": "QuotaPercent specifies the stressors to consume P percent of the cpu quota of the container's\ncgroup. The workers and load are computed by chaos-daemon from the quota when it's set, so it\nconflicts with Workers and Load.\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=100\n+optional",
+                    "type": "integer"
+                },
                 "workers": {
                     "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
                     "type": "integer"
@@ -5110,6 +5125,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.GCPChaosSpec"
                 },
+                "grpcChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.GRPCChaosSpec"
+                },
                 "historyLimit": {
                     "description": "+optional\n+kubebuilder:validation:Minimum=1",
                     "type": "integer"
@@ -5257,6 +5276,17 @@ const docTemplate = `{
                     "type": "string",
                     "default": "0"
                 },
+                "distribution": {
+                    "description": "Distribution represents the statistical distribution of the jitter,\nit's one of normal, pareto, paretonormal, uniform or custom.\nA non-zero jitter is required when the distribution is set.\n+optional\n+kubebuilder:validation:Enum=normal;pareto;paretonormal;uniform;custom",
+                    "type": "string"
+                },
+                "distributionTable": {
+                    "description": "DistributionTable is the user-supplied distribution table, which is only used with the custom distribution.\nThe same as the tables generated by ` + "`" + `maketable` + "`" + ` of iproute2, each value is the scaled offset\n` + "`" + `(x - mean) / stddev * 8192` + "`" + ` in the range of int16.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "jitter": {
                     "description": "+optional",
                     "type": "string",
@@ -5496,6 +5526,94 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.GRPCChaosSpec": {
+            "type": "object",
+            "properties": {
+                "abort": {
+                    "description": "Abort is a rule to terminate a grpc call with the given status.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.PodGrpcChaosAbortAction"
+                },
+                "delay": {
+                    "description": "Delay represents the delay of the target request/response.\nA duration string is a possibly unsigned sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Duration represents the duration of the chaos action.\n+optional",
+                    "type": "string"
+                },
+                "metadata": {
+                    "description": "Metadata is a rule to select target by gRPC metadata,\nthe request metadata for Request target and the response header metadata for Response target.\nThe key-value pairs represent metadata key and metadata value pairs.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "method": {
+                    "description": "Method is a rule to select target by the gRPC method name of the service,\n` + "`" + `SayHello` + "`" + ` for example.\n+optional",
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
+                    "type": "string"
+                },
+                "port": {
+                    "description": "Port represents the target port to be proxy of.\nThe port cannot be injected by HTTPChaos at the same time.",
+                    "type": "integer"
+                },
+                "remoteCluster": {
+                    "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
+                    "type": "string"
+                },
+                "replace": {
+                    "description": "Replace is a rule to replace some contents in target.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.PodGrpcChaosReplaceActions"
+                },
+                "selector": {
+                    "description": "Selector is used to select pods that are used to inject chaos action.",
+                    "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
+                },
+                "service": {
+                    "description": "Service is a rule to select target by the fully-qualified gRPC service name,\n` + "`" + `helloworld.Greeter` + "`" + ` for example.\n+optional",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Target is the object to be selected and injected.\n+kubebuilder:validation:Enum=Request;Response",
+                    "type": "string"
+                },
+                "tls": {
+                    "description": "TLS is the tls config,\nwill override PodGrpcChaos if there are multiple GRPCChaos experiments are applied\n+optional",
+                    "$ref": "#/definitions/v1alpha1.PodHttpChaosTLS"
+                },
+                "value": {
+                    "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.HDDStressor": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "description": "Bytes specifies N bytes written per hdd worker, default is 1GB.\nOne can specify the size as % of free space on the file system or in units of B, KB/KiB,\nMB/MiB, GB/GiB, TB/TiB.\n+optional",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "extend stress-ng options\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "path": {
+                    "description": "Path specifies the directory in the target container to write the temporary files.\n+kubebuilder:default=/tmp\n+optional",
+                    "type": "string"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "v1alpha1.HTTPAbortSpec": {
             "type": "object",
             "properties": {
@@ -5564,7 +5682,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "port": {
-                    "description": "Port represents the target port to be proxy of.",
+                    "description": "Port represents the target port to be proxy of.\nThe port cannot be injected by GRPCChaos at the same time.",
                     "type": "integer"
                 },
                 "remoteCluster": {
@@ -5773,6 +5891,22 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.IOStressor": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "description": "extend stress-ng options\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "v1alpha1.JVMChaosSpec": {
             "type": "object",
             "properties": {
@@ -6118,6 +6252,46 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.LoopSpec": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Count is the max number of iterations.\n+optional\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
+                "interval": {
+                    "description": "Interval is the delay between two iterations.\n+optional",
+                    "type": "string"
+                },
+                "until": {
+                    "description": "Until is the expression evaluated after each iteration, expected type of result is boolean. The loop\nstops once it is evaluated as true. The number of finished iterations is available as \"iteration\", and\nthe context of the last Task in the iteration (like \"exitCode\" and \"stdout\") is also available. The loop\nnode fails if the expression cannot be evaluated.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.LossGEModelSpec": {
+            "type": "object",
+            "properties": {
+                "badLoss": {
+                    "description": "BadLoss is the loss probability in the bad state, which is ` + "`" + `1-h` + "`" + ` in the model.\n+optional",
+                    "type": "string",
+                    "default": "100"
+                },
+                "goodLoss": {
+                    "description": "GoodLoss is the loss probability in the good state, which is ` + "`" + `1-k` + "`" + ` in the model.\n+optional",
+                    "type": "string",
+                    "default": "0"
+                },
+                "p": {
+                    "description": "P is the probability of the transition from the good state to the bad state.",
+                    "type": "string"
+                },
+                "r": {
+                    "description": "R is the probability of the transition from the bad state to the good state.\ndefault: 100 - P.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.LossSpec": {
             "type": "object",
             "properties": {
@@ -6126,7 +6300,30 @@ const docTemplate = `{
                     "type": "string",
                     "default": "0"
                 },
+                "gemodel": {
+                    "description": "GEModel represents the Gilbert-Elliott loss model, which is used to simulate burst losses\ninstead of the independent random loss.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.LossGEModelSpec"
+                },
                 "loss": {
+                    "description": "Loss is the percentage of the independent random loss.\nIt should be zero or omitted when the GEModel is used.\n+optional",
+                    "type": "string",
+                    "default": "0"
+                }
+            }
+        },
+        "v1alpha1.MemoryRamp": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "Duration specifies the time to grow the memory consumption to the size.\nIt conflicts with Step and Interval.\n+optional",
+                    "type": "string"
+                },
+                "interval": {
+                    "description": "Interval specifies the interval between two steps.\n+optional",
+                    "type": "string"
+                },
+                "step": {
+                    "description": "Step specifies the bytes to grow every Interval, in units of B, KB/KiB, MB/MiB, GB/GiB, TB/TiB.\n+optional",
                     "type": "string"
                 }
             }
@@ -6134,6 +6331,10 @@ const docTemplate = `{
         "v1alpha1.MemoryStressor": {
             "type": "object",
             "properties": {
+                "limitPercent": {
+                    "description": "LimitPercent specifies the size as P percent of the memory limit of the container's cgroup,\nwhich is computed by chaos-daemon. It conflicts with Size.\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=100\n+optional",
+                    "type": "integer"
+                },
                 "oomScoreAdj": {
                     "description": "OOMScoreAdj sets the oom_score_adj of the stress process. See ` + "`" + `man 5 proc` + "`" + ` to know more\nabout this option.\n+kubebuilder:validation:Minimum=-1000\n+kubebuilder:validation:Maximum=1000\n+kubebuilder:default=0\n+optional",
                     "type": "integer"
@@ -6145,6 +6346,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "ramp": {
+                    "description": "Ramp specifies how to grow the memory consumption to the size gradually.\nThe memory is allocated immediately if it's not set.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.MemoryRamp"
+                },
                 "size": {
                     "description": "Size specifies N bytes consumed per vm worker, default is the total available memory.\nOne can specify the size as % of total available memory or in units of B, KB/KiB,\nMB/MiB, GB/GiB, TB/TiB.\n+optional",
                     "type": "string"
@@ -6253,6 +6458,17 @@ const docTemplate = `{
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
                 },
+                "ports": {
+                    "description": "Ports represents the ports on the target side of the affected traffic,\nwhich are the destination ports of the packets sent by the selected pods.\nIt requires the protocol to be tcp or udp.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "protocol": {
+                    "description": "Protocol represents the protocol of the affected traffic, all the protocols are affected if it's empty.\n+optional\n+kubebuilder:validation:Enum=tcp;udp;icmp",
+                    "type": "string"
+                },
                 "remoteCluster": {
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
@@ -6261,6 +6477,13 @@ const docTemplate = `{
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
                 },
+                "sourcePorts": {
+                    "description": "SourcePorts represents the ports on the selected pods' side of the affected traffic,\nwhich are the source ports of the packets sent by the selected pods.\nIt requires the protocol to be tcp or udp.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "target": {
                     "description": "Target represents network target, this applies on netem and network partition action\n+optional",
                     "$ref": "#/definitions/v1alpha1.PodSelector"
@@ -6269,6 +6492,13 @@ const docTemplate = `{
                     "description": "TargetDevice represents the network device to be affected in target scope.\n+optional",
                     "type": "string"
                 },
+                "targetServices": {
+                    "description": "TargetServices represents the Kubernetes services as network targets.\nBoth the cluster IP and the current endpoints of each service are affected,\nand they are kept updated during the experiment.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.ServiceTarget"
+                    }
+                },
                 "value": {
                     "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
                     "type": "string"
@@ -6552,6 +6782,22 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.Parameter": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "description": "Default is used when the parameter is not given in the arguments, the parameter is required\nif it has no default value.\n+optional",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.PhysicalMachineChaosSpec": {
             "type": "object",
             "properties": {
@@ -6807,7 +7053,7 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "action": {
-                    "description": "Action defines the specific pod chaos action.\nSupported action: pod-kill / pod-failure / container-kill\nDefault action: pod-kill\n+kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill",
+                    "description": "Action defines the specific pod chaos action.\nSupported action: pod-kill / pod-failure / container-kill / container-pause / process-kill\nDefault action: pod-kill\n+kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill;container-pause;process-kill",
                     "type": "string"
                 },
                 "containerNames": {
@@ -6818,7 +7064,7 @@ const docTemplate = `{
                     }
                 },
                 "duration": {
-                    "description": "Duration represents the duration of the chaos action.\nIt is required when the action is ` + "`" + `PodFailureAction` + "`" + `.\nA duration string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"-1.5h\" or \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
+                    "description": "Duration represents the duration of the chaos action.\nIt is required when the action is ` + "`" + `PodFailureAction` + "`" + ` or ` + "`" + `ContainerPauseAction` + "`" + `.\nA duration string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"-1.5h\" or \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
                     "type": "string"
                 },
                 "gracePeriod": {
@@ -6829,6 +7075,14 @@ const docTemplate = `{
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
                 },
+                "processCmdline": {
+                    "description": "ProcessCmdline is a regular expression matched against the command line of the processes\nin the container, which is used in process-kill action.\nIf both ProcessName and ProcessCmdline are set, a process must match both of them.\n+optional",
+                    "type": "string"
+                },
+                "processName": {
+                    "description": "ProcessName is a regular expression matched against the command name of the processes\nin the container, which is used in process-kill action.\n+optional",
+                    "type": "string"
+                },
                 "remoteCluster": {
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
@@ -6837,12 +7091,48 @@ const docTemplate = `{
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
                 },
+                "signal": {
+                    "description": "Signal is the signal number sent to the processes in process-kill action.\nThe default value is 9 (SIGKILL).\n+optional\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=64",
+                    "type": "integer"
+                },
                 "value": {
                     "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
                     "type": "string"
                 }
             }
         },
+        "v1alpha1.PodGrpcChaosAbortAction": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code is the ` + "`" + `grpc-status` + "`" + ` returned to the caller, ` + "`" + `14` + "`" + ` (UNAVAILABLE) for example.\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=16",
+                    "type": "integer"
+                },
+                "message": {
+                    "description": "Message is the ` + "`" + `grpc-message` + "`" + ` returned to the caller.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.PodGrpcChaosReplaceActions": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "Message is a rule to replace every protobuf-encoded message in target.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "metadata": {
+                    "description": "Metadata is a rule to replace gRPC metadata of target.\nThe key-value pairs represent metadata key and metadata value pairs.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "v1alpha1.PodHttpChaosPatchActions": {
             "type": "object",
             "properties": {
@@ -7175,6 +7465,19 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.RetryStrategy": {
+            "type": "object",
+            "properties": {
+                "backoff": {
+                    "description": "Backoff is the duration to wait before the first retry, and it is doubled for each of the following retries.\n+optional",
+                    "type": "string"
+                },
+                "limit": {
+                    "description": "Limit is the max number of retries.\n+kubebuilder:validation:Minimum=0",
+                    "type": "integer"
+                }
+            }
+        },
         "v1alpha1.Schedule": {
             "type": "object",
             "properties": {
@@ -7301,6 +7604,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.GCPChaosSpec"
                 },
+                "grpcChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.GRPCChaosSpec"
+                },
                 "historyLimit": {
                     "description": "+optional\n+kubebuilder:validation:Minimum=1",
                     "type": "integer"
@@ -7374,6 +7681,43 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.ServiceTarget": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name is the name of the service",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace is the namespace of the service, the namespace of the chaos is used if it's empty\n+optional",
+                    "type": "string"
+                },
+                "port": {
+                    "description": "Port is the port of the service, all the ports are affected if it's not set.\nThe cluster IP is affected on this port, and the endpoints are affected on the corresponding target port.\n+optional\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=65535",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1alpha1.SockStressor": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "description": "extend stress-ng options\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "port": {
+                    "description": "Port specifies the start port of the sock workers, every worker uses one port, default is 5000.\n+kubebuilder:validation:Minimum=1024\n+kubebuilder:validation:Maximum=65535\n+optional",
+                    "type": "integer"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "v1alpha1.StatusCheckSpec": {
             "type": "object",
             "properties": {
@@ -7539,9 +7883,21 @@ const docTemplate = `{
                     "description": "CPUStressor stresses CPU out\n+optional",
                     "$ref": "#/definitions/v1alpha1.CPUStressor"
                 },
+                "hdd": {
+                    "description": "HDDStressor stresses disk out\n+optional",
+                    "$ref": "#/definitions/v1alpha1.HDDStressor"
+                },
+                "io": {
+                    "description": "IOStressor stresses I/O out\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IOStressor"
+                },
                 "memory": {
                     "description": "MemoryStressor stresses virtual memory out\n+optional",
                     "$ref": "#/definitions/v1alpha1.MemoryStressor"
+                },
+                "sock": {
+                    "description": "SockStressor stresses network sockets out\n+optional",
+                    "$ref": "#/definitions/v1alpha1.SockStressor"
                 }
             }
         },
@@ -7581,7 +7937,7 @@ const docTemplate = `{
                     "$ref": "#/definitions/v1alpha1.BlockChaosSpec"
                 },
                 "children": {
-                    "description": "Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.\n+optional",
+                    "description": "Children describes the children steps of serial, parallel or loop node. Only used when Type is TypeSerial,\nTypeParallel or TypeLoop.\n+optional",
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -7606,6 +7962,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.GCPChaosSpec"
                 },
+                "grpcChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.GRPCChaosSpec"
+                },
                 "httpChaos": {
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.HTTPChaosSpec"
@@ -7622,6 +7982,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.KernelChaosSpec"
                 },
+                "loop": {
+                    "description": "Loop describes how many times the children of loop node are repeated. Only used when Type is TypeLoop.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.LoopSpec"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -7629,6 +7993,14 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.NetworkChaosSpec"
                 },
+                "onExit": {
+                    "description": "OnExit is the name of the template to spawn once the node finishes, no matter whether it fails.\nOnly used when Type is TypeTask, TypeSchedule, TypeSerial, TypeParallel or Type\u003cSomething\u003eChaos.\n+optional",
+                    "type": "string"
+                },
+                "onFailure": {
+                    "description": "OnFailure is the name of the template to spawn once the node fails and there are no more retries,\na Serial or Parallel node fails once any of its children fails.\nOnly used when Type is TypeTask, TypeSchedule, TypeSerial, TypeParallel or Type\u003cSomething\u003eChaos.\n+optional",
+                    "type": "string"
+                },
                 "physicalmachineChaos": {
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.PhysicalMachineChaosSpec"
@@ -7637,6 +8009,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.PodChaosSpec"
                 },
+                "retryStrategy": {
+                    "description": "RetryStrategy describes how to retry the node when it fails. Only used when Type is TypeTask, TypeSchedule\nor Type\u003cSomething\u003eChaos.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RetryStrategy"
+                },
                 "schedule": {
                     "description": "Schedule describe the Schedule(describing scheduled chaos) to be injected with chaos nodes. Only used when Type is TypeSchedule.\n+optional",
                     "$ref": "#/definitions/v1alpha1.ChaosOnlyScheduleSpec"
@@ -7653,6 +8029,10 @@ const docTemplate = `{
                     "description": "Task describes the behavior of the custom task. Only used when Type is TypeTask.\n+optional",
                     "$ref": "#/definitions/v1alpha1.Task"
                 },
+                "templateRef": {
+                    "description": "TemplateRef references a template in a WorkflowTemplate, which is resolved when the workflow starts.\nOnly used when Type is TypeTemplateRef.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.TemplateRef"
+                },
                 "templateType": {
                     "type": "string"
                 },
@@ -7662,9 +8042,34 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.TemplateRef": {
+            "type": "object",
+            "properties": {
+                "clusterScope": {
+                    "description": "ClusterScope indicates the template is referenced from a ClusterWorkflowTemplate.\n+optional",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name is the name of the WorkflowTemplate, or the ClusterWorkflowTemplate if ClusterScope is true",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace is the namespace of the WorkflowTemplate, default is the namespace of the Workflow.\nIt should be empty if ClusterScope is true.\n+optional",
+                    "type": "string"
+                },
+                "template": {
+                    "description": "Template is the name of the template in the WorkflowTemplate",
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.TimeChaosSpec": {
             "type": "object",
             "properties": {
+                "clockDriftRate": {
+                    "description": "ClockDriftRate defines the delta time drifted per second of injected program, so the offset grows\nduring the experiment. It's a possibly signed sequence of decimal numbers less than one second, such as\n\"50ms\" or \"-1.5ms\". For example, with timeOffset \"0s\" and clockDriftRate \"50ms\", the clock is 3s ahead\nafter one minute.\n+optional",
+                    "type": "string"
+                },
                 "clockIds": {
                     "description": "ClockIds defines all affected clock id\nAll available options are [\"CLOCK_REALTIME\",\"CLOCK_MONOTONIC\",\"CLOCK_PROCESS_CPUTIME_ID\",\"CLOCK_THREAD_CPUTIME_ID\",\n\"CLOCK_MONOTONIC_RAW\",\"CLOCK_REALTIME_COARSE\",\"CLOCK_MONOTONIC_COARSE\",\"CLOCK_BOOTTIME\",\"CLOCK_REALTIME_ALARM\",\n\"CLOCK_BOOTTIME_ALARM\"]\nDefault value is [\"CLOCK_REALTIME\"]",
                     "type": "array",
@@ -7858,9 +8263,23 @@ const docTemplate = `{
         "v1alpha1.WorkflowSpec": {
             "type": "object",
             "properties": {
+                "arguments": {
+                    "description": "Arguments gives the values of the parameters for this workflow.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.Argument"
+                    }
+                },
                 "entry": {
                     "type": "string"
                 },
+                "parameters": {
+                    "description": "Parameters declares the parameters of the workflow, which could be referenced as\n\"{{workflow.parameters.\u003cname\u003e}}\" in the templates.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.Parameter"
+                    }
+                },
                 "templates": {
                     "type": "array",
                     "items": {
@@ -45,6 +45,12 @@ func FromDelay(in *v1alpha1.DelaySpec) (*chaosdaemonpb.Netem, error) {
 		Jitter:    uint32(jitter.Nanoseconds() / 1e3),
 	}
 
+	// netem uses the uniform distribution if there is no distribution table
+	if in.Distribution != "" && in.Distribution != v1alpha1.UniformDistribution {
+		netem.Distribution = string(in.Distribution)
+		netem.DistributionTable = in.DistributionTable
+	}
+
 	if in.Reorder != nil {
 		reorderPercentage, err := strconv.ParseFloat(in.Reorder.Reorder, 32)
 		if err != nil {
@@ -76,9 +82,52 @@ func FromLoss(in *v1alpha1.LossSpec) (*chaosdaemonpb.Netem, error) {
 		return nil, err
 	}
 
-	return &chaosdaemonpb.Netem{
+	netem := &chaosdaemonpb.Netem{
 		Loss:     float32(lossPercentage),
 		LossCorr: float32(corr),
+	}
+
+	if in.GEModel != nil {
+		geModel, err := fromLossGEModel(in.GEModel)
+		if err != nil {
+			return nil, err
+		}
+		netem.LossGemodel = geModel
+	}
+
+	return netem, nil
+}
+
+func fromLossGEModel(in *v1alpha1.LossGEModelSpec) (*chaosdaemonpb.LossGEModel, error) {
+	p, err := strconv.ParseFloat(in.P, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transition probability from the bad state is 100 - p by default
+	r := 100 - p
+	if in.R != nil {
+		r, err = strconv.ParseFloat(*in.R, 32)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	badLoss, err := strconv.ParseFloat(in.BadLoss, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	goodLoss, err := strconv.ParseFloat(in.GoodLoss, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chaosdaemonpb.LossGEModel{
+		P:        float32(p),
+		R:        float32(r),
+		BadLoss:  float32(badLoss),
+		GoodLoss: float32(goodLoss),
 	}, nil
 }
 
@@ -21,6 +21,10 @@
 extern int64_t TV_SEC_DELTA;
 extern int64_t TV_NSEC_DELTA;
 extern uint64_t CLOCK_IDS_MASK;
+// DRIFT_RATE is the nanoseconds drifted per second, and DRIFT_START is the
+// CLOCK_MONOTONIC time (in nanoseconds) when the drift starts.
+extern int64_t DRIFT_RATE;
+extern int64_t DRIFT_START;
 
 #if defined(__amd64__)
 inline int real_clock_gettime(clockid_t clk_id, struct timespec *tp) {
@@ -57,11 +61,25 @@ int fake_clock_gettime(clockid_t clk_id, struct timespec *tp) {
     int64_t sec_delta = TV_SEC_DELTA;
     int64_t nsec_delta = TV_NSEC_DELTA;
     uint64_t clock_ids_mask = CLOCK_IDS_MASK;
+    int64_t drift_rate = DRIFT_RATE;
+    int64_t drift_start = DRIFT_START;
 
     int64_t billion = 1000000000;
 
     uint64_t clk_id_mask = 1 << clk_id;
     if((clk_id_mask & clock_ids_mask) != 0) {
+        if (drift_rate != 0) {
+            struct timespec now;
+            real_clock_gettime(CLOCK_MONOTONIC, &now);
+
+            // split the elapsed time to avoid overflow
+            int64_t elapsed = now.tv_sec * billion + now.tv_nsec - drift_start;
+            nsec_delta += (elapsed / billion) * drift_rate + (elapsed % billion) * drift_rate / billion;
+
+            sec_delta += nsec_delta / billion;
+            nsec_delta = nsec_delta % billion;
+        }
+
         while (nsec_delta + tp->tv_nsec > billion) {
             sec_delta += 1;
             nsec_delta -= billion;
@@ -22,6 +22,9 @@
 
 extern int64_t TV_SEC_DELTA;
 extern int64_t TV_NSEC_DELTA;
+// DRIFT_RATE and DRIFT_START have the same meaning as in fake_clock_gettime.c
+extern int64_t DRIFT_RATE;
+extern int64_t DRIFT_START;
 
 #if defined(__amd64__)
 inline int real_gettimeofday(struct timeval *tv, struct timezone *tz)
@@ -36,6 +39,19 @@ inline int real_gettimeofday(struct timeval *tv, struct timezone *tz)
     return ret;
 }
 
+inline int real_clock_gettime(clockid_t clk_id, struct timespec *tp) {
+    int ret;
+    asm volatile
+        (
+            "syscall"
+            : "=a" (ret)
+            : "0"(__NR_clock_gettime), "D"(clk_id), "S"(tp)
+            : "rcx", "r11", "memory"
+        );
+
+    return ret;
+}
+
 #elif defined(__aarch64__)
 inline int real_gettimeofday(struct timeval *tv, struct timezone *tz)
 {
@@ -52,6 +68,20 @@ inline int real_gettimeofday(struct timeval *tv, struct timezone *tz)
 
     return w0;
 }
+
+inline int real_clock_gettime(clockid_t clk_id, struct timespec *tp) {
+    register clockid_t x0 __asm__ ("x0") = clk_id;
+    register struct timespec *x1 __asm__ ("x1") = tp;
+    register uint64_t w8 __asm__ ("w8") = __NR_clock_gettime; /* syscall number */
+    __asm__ __volatile__ (
+        "svc 0;"
+        : "+r" (x0)
+        : "r" (x0), "r" (x1), "r" (w8)
+        : "memory"
+    );
+
+    return x0;
+}
 #endif
 
 int fake_gettimeofday(struct timeval *tv, struct timezone *tz)
@@ -60,8 +90,23 @@ int fake_gettimeofday(struct timeval *tv, struct timezone *tz)
 
     int64_t sec_delta = TV_SEC_DELTA;
     int64_t nsec_delta = TV_NSEC_DELTA;
+    int64_t drift_rate = DRIFT_RATE;
+    int64_t drift_start = DRIFT_START;
     int64_t billion = 1000000000;
 
+    if (drift_rate != 0)
+    {
+        struct timespec now;
+        real_clock_gettime(CLOCK_MONOTONIC, &now);
+
+        // split the elapsed time to avoid overflow
+        int64_t elapsed = now.tv_sec * billion + now.tv_nsec - drift_start;
+        nsec_delta += (elapsed / billion) * drift_rate + (elapsed % billion) * drift_rate / billion;
+
+        sec_delta += nsec_delta / billion;
+        nsec_delta = nsec_delta % billion;
+    }
+
     while (nsec_delta + tv->tv_usec*1000 > billion)
     {
         sec_delta += 1;
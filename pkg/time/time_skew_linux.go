@@ -32,11 +32,13 @@ const clockGettimeSkewFakeImage = "fake_clock_gettime.o"
 // clockGettime is the target function would be replaced
 const clockGettime = "clock_gettime"
 
-// These three consts corresponding to the three extern variables in the fake_clock_gettime.c
+// These consts corresponding to the extern variables in the fake_clock_gettime.c
 const (
 	externVarClockIdsMask = "CLOCK_IDS_MASK"
 	externVarTvSecDelta   = "TV_SEC_DELTA"
 	externVarTvNsecDelta  = "TV_NSEC_DELTA"
+	externVarDriftRate    = "DRIFT_RATE"
+	externVarDriftStart   = "DRIFT_START"
 )
 
 // timeofdaySkewFakeImage is the filename of fake image after compiling
@@ -54,13 +56,25 @@ type Config struct {
 	deltaSeconds     int64
 	deltaNanoSeconds int64
 	clockIDsMask     uint64
+	// driftRate is the nanoseconds drifted per second since driftStart,
+	// driftStart is the CLOCK_MONOTONIC time in nanoseconds.
+	driftRate  int64
+	driftStart int64
 }
 
 func NewConfig(deltaSeconds int64, deltaNanoSeconds int64, clockIDsMask uint64) Config {
+	return NewConfigWithDrift(deltaSeconds, deltaNanoSeconds, clockIDsMask, 0, 0)
+}
+
+// NewConfigWithDrift creates a Config whose offset grows driftRate nanoseconds
+// per second, starting from the CLOCK_MONOTONIC time driftStart.
+func NewConfigWithDrift(deltaSeconds int64, deltaNanoSeconds int64, clockIDsMask uint64, driftRate int64, driftStart int64) Config {
 	return Config{
 		deltaSeconds:     deltaSeconds,
 		deltaNanoSeconds: deltaNanoSeconds,
 		clockIDsMask:     clockIDsMask,
+		driftRate:        driftRate,
+		driftStart:       driftStart,
 	}
 }
 
@@ -69,6 +83,8 @@ func (c *Config) DeepCopy() tasks.Object {
 		c.deltaSeconds,
 		c.deltaNanoSeconds,
 		c.clockIDsMask,
+		c.driftRate,
+		c.driftStart,
 	}
 }
 
@@ -80,11 +96,55 @@ func (c *Config) Merge(a tasks.Mergeable) error {
 		c.deltaSeconds += A.deltaSeconds
 		c.deltaNanoSeconds += A.deltaNanoSeconds
 		c.clockIDsMask |= A.clockIDsMask
+		c.mergeDrift(A)
 		return nil
 	}
 	return cerr.NotType[*Config]().WrapInput(a).Err()
 }
 
+// mergeDrift merges the drift of a into c. The drift of a is rebased on the
+// driftStart of c, and the difference caused by rebasing is moved into the
+// fixed delta, so the sum of the two drifts keeps the same at any time.
+func (c *Config) mergeDrift(a *Config) {
+	if a.driftRate == 0 {
+		return
+	}
+	if c.driftRate == 0 {
+		c.driftRate = a.driftRate
+		c.driftStart = a.driftStart
+		return
+	}
+
+	c.deltaNanoSeconds += driftNanoSeconds(a.driftRate, c.driftStart-a.driftStart)
+	c.driftRate += a.driftRate
+}
+
+// driftNanoSeconds returns the nanoseconds drifted with driftRate after elapsed nanoseconds.
+// It's the same as the calculation in the fake images.
+func driftNanoSeconds(driftRate int64, elapsed int64) int64 {
+	const billion = 1000000000
+	return (elapsed/billion)*driftRate + (elapsed%billion)*driftRate/billion
+}
+
+func (c *Config) clockGetTimeVariables() map[string]uint64 {
+	return map[string]uint64{
+		externVarClockIdsMask: c.clockIDsMask,
+		externVarTvSecDelta:   uint64(c.deltaSeconds),
+		externVarTvNsecDelta:  uint64(c.deltaNanoSeconds),
+		externVarDriftRate:    uint64(c.driftRate),
+		externVarDriftStart:   uint64(c.driftStart),
+	}
+}
+
+func (c *Config) getTimeOfDayVariables() map[string]uint64 {
+	return map[string]uint64{
+		externVarTvSecDelta:  uint64(c.deltaSeconds),
+		externVarTvNsecDelta: uint64(c.deltaNanoSeconds),
+		externVarDriftRate:   uint64(c.driftRate),
+		externVarDriftStart:  uint64(c.driftStart),
+	}
+}
+
 type ConfigCreatorParas struct {
 	Logger        logr.Logger
 	Config        Config
@@ -192,19 +252,12 @@ func (s *Skew) Inject(pid tasks.IsID) error {
 
 	s.logger.Info("injecting time skew", "pid", pid)
 
-	err := s.clockGetTime.AttachToProcess(int(sysPID), map[string]uint64{
-		externVarClockIdsMask: s.SkewConfig.clockIDsMask,
-		externVarTvSecDelta:   uint64(s.SkewConfig.deltaSeconds),
-		externVarTvNsecDelta:  uint64(s.SkewConfig.deltaNanoSeconds),
-	})
+	err := s.clockGetTime.AttachToProcess(int(sysPID), s.SkewConfig.clockGetTimeVariables())
 	if err != nil {
 		return err
 	}
 
-	err = s.getTimeOfDay.AttachToProcess(int(sysPID), map[string]uint64{
-		externVarTvSecDelta:  uint64(s.SkewConfig.deltaSeconds),
-		externVarTvNsecDelta: uint64(s.SkewConfig.deltaNanoSeconds),
-	})
+	err = s.getTimeOfDay.AttachToProcess(int(sysPID), s.SkewConfig.getTimeOfDayVariables())
 	if err != nil {
 		return err
 	}
@@ -224,26 +277,16 @@ func (s *Skew) Recover(pid tasks.IsID) error {
 
 	s.logger.Info("recovering time skew", "pid", pid)
 
-	err1 := s.clockGetTime.Recover(int(sysPID), map[string]uint64{
-		externVarClockIdsMask: s.SkewConfig.clockIDsMask,
-		externVarTvSecDelta:   uint64(s.SkewConfig.deltaSeconds),
-		externVarTvNsecDelta:  uint64(s.SkewConfig.deltaNanoSeconds),
-	})
+	err1 := s.clockGetTime.Recover(int(sysPID), s.SkewConfig.clockGetTimeVariables())
 	if err1 != nil {
-		err2 := s.getTimeOfDay.Recover(int(sysPID), map[string]uint64{
-			externVarTvSecDelta:  uint64(s.SkewConfig.deltaSeconds),
-			externVarTvNsecDelta: uint64(s.SkewConfig.deltaNanoSeconds),
-		})
+		err2 := s.getTimeOfDay.Recover(int(sysPID), s.SkewConfig.getTimeOfDayVariables())
 		if err2 != nil {
 			return errors.Wrapf(err1, "time skew all failed %v", err2)
 		}
 		return err1
 	}
 
-	err2 := s.getTimeOfDay.Recover(int(sysPID), map[string]uint64{
-		externVarTvSecDelta:  uint64(s.SkewConfig.deltaSeconds),
-		externVarTvNsecDelta: uint64(s.SkewConfig.deltaNanoSeconds),
-	})
+	err2 := s.getTimeOfDay.Recover(int(sysPID), s.SkewConfig.getTimeOfDayVariables())
 	if err2 != nil {
 		return err2
 	}
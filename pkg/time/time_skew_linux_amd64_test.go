@@ -18,9 +18,11 @@ package time
 import (
 	"os"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
 	"sigs.k8s.io/controller-runtime/pkg/envtest/printer"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tasks"
@@ -134,5 +136,33 @@ var _ = Describe("ModifyTime", func() {
 			newSec := newTime.Unix()
 			Expect(newSec-sec).Should(BeNumerically(">=", 1), "sec %d newSec %d", sec, newSec)
 		})
+
+		It("should drift successfully", func() {
+			Expect(t).NotTo(BeNil())
+
+			var start unix.Timespec
+			err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &start)
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			// drift 1000 seconds per second
+			s, err := GetSkew(logger, NewConfigWithDrift(0, 0, 1, 1000*1000000000, start.Nano()))
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			err = s.Inject(tasks.SysPID(t.Pid()))
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			now, err := t.GetTime()
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			time.Sleep(time.Second)
+
+			newTime, err := t.GetTime()
+			Expect(err).ShouldNot(HaveOccurred(), "error: %+v", err)
+
+			sec := now.Unix()
+			newSec := newTime.Unix()
+			Expect(newSec-sec).Should(BeNumerically(">=", 1000), "sec %d newSec %d", sec, newSec)
+			Expect(newSec-sec).Should(BeNumerically("<=", 1100), "sec %d newSec %d", sec, newSec)
+		})
 	})
 })
@@ -0,0 +1,64 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package time
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDriftNanoSeconds(t *testing.T) {
+	g := NewWithT(t)
+
+	// 50ms per second
+	g.Expect(driftNanoSeconds(50000000, 0)).To(Equal(int64(0)))
+	g.Expect(driftNanoSeconds(50000000, 500000000)).To(Equal(int64(25000000)))
+	g.Expect(driftNanoSeconds(50000000, 10*1000000000)).To(Equal(int64(500000000)))
+	g.Expect(driftNanoSeconds(-50000000, 10*1000000000)).To(Equal(int64(-500000000)))
+
+	// should not overflow after a year
+	year := int64(365 * 24 * 3600 * 1000000000)
+	g.Expect(driftNanoSeconds(50000000, year)).To(Equal(int64(365 * 24 * 3600 * 50000000)))
+}
+
+func TestMergeDrift(t *testing.T) {
+	g := NewWithT(t)
+
+	const second = int64(1000000000)
+
+	c := NewConfigWithDrift(1, 0, 1, 50000000, 100*second)
+	a := NewConfigWithDrift(2, 0, 2, 10000000, 90*second)
+	g.Expect(c.Merge(&a)).To(Succeed())
+
+	g.Expect(c.deltaSeconds).To(Equal(int64(3)))
+	g.Expect(c.clockIDsMask).To(Equal(uint64(3)))
+	g.Expect(c.driftRate).To(Equal(int64(60000000)))
+	g.Expect(c.driftStart).To(Equal(100 * second))
+
+	// at any time, the merged drift equals to the sum of the two drifts
+	now := 200 * second
+	merged := c.deltaNanoSeconds + driftNanoSeconds(c.driftRate, now-c.driftStart)
+	expected := driftNanoSeconds(50000000, now-100*second) + driftNanoSeconds(10000000, now-90*second)
+	g.Expect(merged).To(Equal(expected))
+
+	// merge into a config without drift
+	c = NewConfig(1, 0, 1)
+	g.Expect(c.Merge(&a)).To(Succeed())
+	g.Expect(c.driftRate).To(Equal(int64(10000000)))
+	g.Expect(c.driftStart).To(Equal(90 * second))
+	g.Expect(c.deltaNanoSeconds).To(Equal(int64(0)))
+}
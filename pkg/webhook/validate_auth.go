@@ -19,16 +19,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
 	authv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/templateref"
 )
 
 var alwaysAllowedKind = []string{
@@ -37,6 +41,7 @@ var alwaysAllowedKind = []string{
 	v1alpha1.KindPodIOChaos,
 	v1alpha1.KindGCPChaos,
 	v1alpha1.KindPodHttpChaos,
+	v1alpha1.KindPodGrpcChaos,
 	v1alpha1.KindPhysicalMachine,
 	v1alpha1.KindStatusCheck,
 	v1alpha1.KindRemoteCluster,
@@ -51,6 +56,7 @@ type AuthValidator struct {
 	enabled bool
 	authCli *authorizationv1.AuthorizationV1Client
 
+	client  client.Client
 	decoder *admission.Decoder
 
 	clusterScoped         bool
@@ -74,30 +80,40 @@ func NewAuthValidator(enabled bool, authCli *authorizationv1.AuthorizationV1Clie
 
 // AuthValidator admits a pod iff a specific annotation exists.
 func (v *AuthValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
-	if !v.enabled {
-		return admission.Allowed("")
-	}
-
 	username := req.UserInfo.Username
 	groups := req.UserInfo.Groups
 	requestKind := req.Kind.Kind
 
+	// the template references of workflows are always resolved, so the missing templates are rejected
+	if !v.enabled && requestKind != v1alpha1.KindWorkflow {
+		return admission.Allowed("")
+	}
+
 	if contains(alwaysAllowedKind, requestKind) {
 		return admission.Allowed(fmt.Sprintf("skip the RBAC check for type %s", requestKind))
 	}
 
-	kind, ok := v1alpha1.AllKindsIncludeScheduleAndWorkflow()[requestKind]
-	if !ok {
-		err := errors.Wrapf(errInvalidValue, "kind %s is not support", requestKind)
+	chaos, err := spawnObject(requestKind)
+	if err != nil {
 		return admission.Errored(http.StatusBadRequest, err)
 	}
-	chaos := kind.SpawnObject()
 
-	err := v.decoder.Decode(req, chaos)
+	err = v.decoder.Decode(req, chaos)
 	if err != nil {
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
+	if workflow, ok := chaos.(*v1alpha1.Workflow); ok && v.templatesChanged(req, workflow) {
+		resolved, response := v.resolveTemplateRefs(ctx, username, groups, workflow)
+		if response != nil {
+			return *response
+		}
+		chaos = resolved
+	}
+	if !v.enabled {
+		return admission.Allowed("")
+	}
+
 	requireClusterPrivileges, affectedNamespaces := affectedNamespaces(chaos)
 
 	if requireClusterPrivileges {
@@ -130,6 +146,87 @@ func (v *AuthValidator) Handle(ctx context.Context, req admission.Request) admis
 	return admission.Allowed("")
 }
 
+// resolveTemplateRefs checks whether the user could get the libraries referenced by the workflow if the security mode
+// is enabled, and returns the workflow with the references resolved, so the selectors in the referenced templates are
+// also validated.
+func (v *AuthValidator) resolveTemplateRefs(ctx context.Context, username string, groups []string, workflow *v1alpha1.Workflow) (*v1alpha1.Workflow, *admission.Response) {
+	checked := make(map[templateref.Library]struct{})
+	for _, template := range workflow.Spec.Templates {
+		if template.Type != v1alpha1.TypeTemplateRef || template.TemplateRef == nil {
+			continue
+		}
+
+		library := templateref.LibraryOf(workflow.Namespace, template.TemplateRef)
+		if _, ok := checked[library]; ok {
+			continue
+		}
+		checked[library] = struct{}{}
+		if !v.enabled {
+			continue
+		}
+
+		resource := "workflowtemplates"
+		if library.ClusterScope {
+			resource = "clusterworkflowtemplates"
+		}
+		allow, err := v.review(username, groups, authv1.ResourceAttributes{
+			Namespace: library.Namespace,
+			Verb:      "get",
+			Group:     "chaos-mesh.org",
+			Resource:  resource,
+			Name:      library.Name,
+		})
+		if err != nil {
+			response := admission.Errored(http.StatusBadRequest, err)
+			return nil, &response
+		}
+		if !allow {
+			response := admission.Denied(fmt.Sprintf("%s is forbidden to get %s", username, library))
+			return nil, &response
+		}
+	}
+	if len(checked) == 0 {
+		return workflow, nil
+	}
+
+	templates, err := templateref.Resolve(ctx, v.client, workflow)
+	if err != nil {
+		var invalid *templateref.InvalidTemplateRefError
+		if errors.As(err, &invalid) {
+			response := admission.Denied(invalid.Error())
+			return nil, &response
+		}
+		response := admission.Errored(http.StatusInternalServerError, err)
+		return nil, &response
+	}
+
+	resolved := workflow.DeepCopy()
+	resolved.Spec.Templates = templates
+	return resolved, nil
+}
+
+// templatesChanged returns false if the request updates the workflow without changing the templates, so the removal
+// of finalizers would not be blocked by the libraries deleted after the workflow is created.
+func (v *AuthValidator) templatesChanged(req admission.Request, workflow *v1alpha1.Workflow) bool {
+	if req.Operation != admissionv1.Update {
+		return true
+	}
+	old := &v1alpha1.Workflow{}
+	if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+		return true
+	}
+	return !reflect.DeepEqual(old.Spec.Templates, workflow.Spec.Templates)
+}
+
+// AuthValidator implements inject.Client.
+// A client will be automatically injected.
+
+// InjectClient injects the client.
+func (v *AuthValidator) InjectClient(c client.Client) error {
+	v.client = c
+	return nil
+}
+
 // AuthValidator implements admission.DecoderInjector.
 // A decoder will be automatically injected.
 
@@ -144,16 +241,20 @@ func (v *AuthValidator) auth(username string, groups []string, namespace string,
 	if err != nil {
 		return false, err
 	}
+	return v.review(username, groups, authv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "create",
+		Group:     "chaos-mesh.org",
+		Resource:  resourceName,
+	})
+}
+
+func (v *AuthValidator) review(username string, groups []string, attributes authv1.ResourceAttributes) (bool, error) {
 	sar := authv1.SubjectAccessReview{
 		Spec: authv1.SubjectAccessReviewSpec{
-			ResourceAttributes: &authv1.ResourceAttributes{
-				Namespace: namespace,
-				Verb:      "create",
-				Group:     "chaos-mesh.org",
-				Resource:  resourceName,
-			},
-			User:   username,
-			Groups: groups,
+			ResourceAttributes: &attributes,
+			User:               username,
+			Groups:             groups,
 		},
 	}
 
@@ -166,6 +267,23 @@ func (v *AuthValidator) auth(username string, groups []string, namespace string,
 	return response.Status.Allowed, nil
 }
 
+// spawnObject returns an empty object of the kind, the libraries of workflow templates are validated like the
+// workflows, as the selectors in them would be used by the workflows referencing them.
+func spawnObject(requestKind string) (client.Object, error) {
+	switch requestKind {
+	case v1alpha1.KindWorkflowTemplate:
+		return &v1alpha1.WorkflowTemplate{}, nil
+	case v1alpha1.KindClusterWorkflowTemplate:
+		return &v1alpha1.ClusterWorkflowTemplate{}, nil
+	}
+
+	kind, ok := v1alpha1.AllKindsIncludeScheduleAndWorkflow()[requestKind]
+	if !ok {
+		return nil, errors.Wrapf(errInvalidValue, "kind %s is not support", requestKind)
+	}
+	return kind.SpawnObject(), nil
+}
+
 func (v *AuthValidator) resourceFor(name string) (string, error) {
 	// TODO: we should use RESTMapper, but it relates to many dependencies
 	return strings.ToLower(name), nil
@@ -0,0 +1,107 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestAuthValidatorResolveTemplateRefs(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(gomega.Succeed())
+	decoder, err := admission.NewDecoder(scheme)
+	g.Expect(err).To(gomega.BeNil())
+
+	validator := NewAuthValidator(false, nil, true, "", false, logr.Discard())
+	g.Expect(validator.InjectDecoder(decoder)).To(gomega.Succeed())
+	g.Expect(validator.InjectClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1alpha1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "network"},
+			Spec: v1alpha1.WorkflowTemplateSpec{
+				Templates: []v1alpha1.Template{{Name: "delay", Type: v1alpha1.TypeSuspend}},
+			},
+		},
+	).Build())).To(gomega.Succeed())
+
+	request := func(operation admissionv1.Operation, templateRef, oldTemplateRef *v1alpha1.TemplateRef) admission.Request {
+		raw := func(templateRef *v1alpha1.TemplateRef) runtime.RawExtension {
+			workflow := v1alpha1.Workflow{
+				TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: v1alpha1.KindWorkflow},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "workflow"},
+				Spec: v1alpha1.WorkflowSpec{
+					Entry: "the-fault",
+					Templates: []v1alpha1.Template{
+						{Name: "the-fault", Type: v1alpha1.TypeTemplateRef, TemplateRef: templateRef},
+					},
+				},
+			}
+			data, err := json.Marshal(workflow)
+			g.Expect(err).To(gomega.BeNil())
+			return runtime.RawExtension{Raw: data}
+		}
+
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Group: v1alpha1.GroupVersion.Group, Version: v1alpha1.GroupVersion.Version, Kind: v1alpha1.KindWorkflow},
+			Operation: operation,
+			Object:    raw(templateRef),
+		}}
+		if oldTemplateRef != nil {
+			req.OldObject = raw(oldTemplateRef)
+		}
+		return req
+	}
+
+	existing := &v1alpha1.TemplateRef{Name: "network", Template: "delay"}
+	missingTemplate := &v1alpha1.TemplateRef{Name: "network", Template: "loss"}
+	missingLibrary := &v1alpha1.TemplateRef{ClusterScope: true, Name: "network", Template: "delay"}
+
+	t.Run("allow the existing template", func(t *testing.T) {
+		response := validator.Handle(context.TODO(), request(admissionv1.Create, existing, nil))
+		g.Expect(response.Allowed).To(gomega.BeTrue())
+	})
+
+	t.Run("deny the missing templates", func(t *testing.T) {
+		response := validator.Handle(context.TODO(), request(admissionv1.Create, missingTemplate, nil))
+		g.Expect(response.Allowed).To(gomega.BeFalse())
+		g.Expect(string(response.Result.Reason)).To(gomega.ContainSubstring("template loss not found"))
+
+		response = validator.Handle(context.TODO(), request(admissionv1.Create, missingLibrary, nil))
+		g.Expect(response.Allowed).To(gomega.BeFalse())
+		g.Expect(string(response.Result.Reason)).To(gomega.ContainSubstring("ClusterWorkflowTemplate network not found"))
+
+		response = validator.Handle(context.TODO(), request(admissionv1.Update, missingTemplate, existing))
+		g.Expect(response.Allowed).To(gomega.BeFalse())
+	})
+
+	t.Run("skip the update without changing templates", func(t *testing.T) {
+		response := validator.Handle(context.TODO(), request(admissionv1.Update, missingLibrary, missingLibrary))
+		g.Expect(response.Allowed).To(gomega.BeTrue())
+	})
+}
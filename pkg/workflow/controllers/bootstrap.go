@@ -86,6 +86,21 @@ func BootstrapWorkflowControllers(mgr manager.Manager, logger logr.Logger, recor
 		return err
 	}
 
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Owns(&v1alpha1.WorkflowNode{}).
+		Named("workflow-loop-node-reconciler").
+		Complete(
+			NewLoopNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-loop-node-reconciler"),
+				logger.WithName("workflow-loop-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
 	err = ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.WorkflowNode{}).
 		Named("workflow-deadline-reconciler").
@@ -100,8 +115,13 @@ func BootstrapWorkflowControllers(mgr manager.Manager, logger logr.Logger, recor
 		return err
 	}
 
-	err = ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.WorkflowNode{}).
+	// watch on the chaos created by the chaos nodes, to find out the failure of injection
+	chaosNodeBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{})
+	for _, kind := range v1alpha1.AllKinds() {
+		chaosNodeBuilder = chaosNodeBuilder.Owns(kind.SpawnObject())
+	}
+	err = chaosNodeBuilder.
 		Named("workflow-chaos-node-reconciler").
 		Complete(
 			NewChaosNodeReconciler(
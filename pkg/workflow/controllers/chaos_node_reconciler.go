@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -33,14 +34,24 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 )
 
+// chaosApplyFailureThreshold is the number of failed injections in a row to consider the chaos as failed,
+// as the chaos controller keeps retrying the injection by itself.
+const chaosApplyFailureThreshold = 3
+
 type ChaosNodeReconciler struct {
+	*HookNodesSpawner
 	kubeClient    client.Client
 	eventRecorder recorder.ChaosRecorder
 	logger        logr.Logger
 }
 
 func NewChaosNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *ChaosNodeReconciler {
-	return &ChaosNodeReconciler{kubeClient: kubeClient, eventRecorder: eventRecorder, logger: logger}
+	return &ChaosNodeReconciler{
+		HookNodesSpawner: NewHookNodesSpawner(kubeClient, eventRecorder, logger),
+		kubeClient:       kubeClient,
+		eventRecorder:    eventRecorder,
+		logger:           logger,
+	}
 }
 
 func (it *ChaosNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -66,13 +77,14 @@ func (it *ChaosNodeReconciler) Reconcile(ctx context.Context, request reconcile.
 
 	it.logger.V(4).Info("resolve chaos node", "node", request)
 
+	var requeueAfter time.Duration
 	if node.Spec.Type == v1alpha1.TypeSchedule {
-		err := it.syncSchedule(ctx, node)
+		requeueAfter, err = it.syncSchedule(ctx, node)
 		if err != nil {
 			return reconcile.Result{}, err
 		}
 	} else {
-		err = it.syncChaosResources(ctx, node)
+		requeueAfter, err = it.syncChaosResources(ctx, node)
 		if err != nil {
 			return reconcile.Result{}, err
 		}
@@ -160,16 +172,20 @@ func (it *ChaosNodeReconciler) Reconcile(ctx context.Context, request reconcile.
 
 		return client.IgnoreNotFound(it.kubeClient.Status().Update(ctx, &nodeNeedUpdate))
 	})
+	if updateError != nil {
+		return reconcile.Result{}, updateError
+	}
 
-	return reconcile.Result{}, updateError
+	return reconcile.Result{RequeueAfter: requeueAfter}, it.syncHookNodes(ctx, request.NamespacedName)
 }
 
-func (it *ChaosNodeReconciler) syncSchedule(ctx context.Context, node v1alpha1.WorkflowNode) error {
+// syncSchedule reconciles the schedule of the node, it returns a duration if the creation should be retried later.
+func (it *ChaosNodeReconciler) syncSchedule(ctx context.Context, node v1alpha1.WorkflowNode) (time.Duration, error) {
 	scheduleList, err := it.fetchChildrenSchedule(ctx, node)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	if WorkflowNodeFinished(node.Status) {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodeFailed(node.Status) {
 		// make the number of schedule to 0
 		for _, item := range scheduleList {
 			item := item
@@ -191,10 +207,10 @@ func (it *ChaosNodeReconciler) syncSchedule(ctx context.Context, node v1alpha1.W
 				})
 			}
 		}
-		return nil
+		return 0, nil
 	}
 	if len(scheduleList) == 0 {
-		return it.createSchedule(ctx, node)
+		return it.createWithRetry(ctx, node, it.createSchedule)
 	} else if len(scheduleList) > 1 {
 		// need cleanup
 
@@ -223,18 +239,18 @@ func (it *ChaosNodeReconciler) syncSchedule(ctx context.Context, node v1alpha1.W
 	} else {
 		it.logger.V(4).Info("do not need spawn or remove schedule CR")
 	}
-	return nil
-
+	return 0, nil
 }
 
-func (it *ChaosNodeReconciler) syncChaosResources(ctx context.Context, node v1alpha1.WorkflowNode) error {
+// syncChaosResources reconciles the chaos of the node, it returns a duration if the creation should be retried later.
+func (it *ChaosNodeReconciler) syncChaosResources(ctx context.Context, node v1alpha1.WorkflowNode) (time.Duration, error) {
 
 	chaosList, err := it.fetchChildrenChaosCustomResource(ctx, node)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	if WorkflowNodeFinished(node.Status) {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodeFailed(node.Status) {
 		// make the number of chaos resource to 0
 		for _, item := range chaosList {
 			// best efforts deletion
@@ -258,11 +274,11 @@ func (it *ChaosNodeReconciler) syncChaosResources(ctx context.Context, node v1al
 				})
 			}
 		}
-		return nil
+		return 0, nil
 	}
 	// make the number of chaos resource to 1
 	if len(chaosList) == 0 {
-		return it.createChaos(ctx, node)
+		return it.createWithRetry(ctx, node, it.createChaos)
 	} else if len(chaosList) > 1 {
 
 		var chaosCrToRemove []string
@@ -293,7 +309,127 @@ func (it *ChaosNodeReconciler) syncChaosResources(ctx context.Context, node v1al
 
 	// TODO: also respawn the chaos resource if Spec changed in workflow
 
-	return nil
+	return it.syncChaosInjection(ctx, node, chaosList[0])
+}
+
+// syncChaosInjection records the failure of the chaos which could not be injected with the retry strategy of the node.
+// The chaos is removed if it could be retried, and it will be created again after the backoff.
+func (it *ChaosNodeReconciler) syncChaosInjection(ctx context.Context, node v1alpha1.WorkflowNode, chaos v1alpha1.GenericChaos) (time.Duration, error) {
+	cause, failed := chaosInjectionFailed(chaos)
+	if !failed {
+		return 0, nil
+	}
+
+	var backoff time.Duration
+	var err error
+	retrying := true
+	if failureRecorded(node.Status.RetryStatus, chaos.GetCreationTimestamp()) {
+		// the failure has been recorded, but the chaos is not removed yet
+		backoff, err = retryWait(node.Spec.RetryStrategy, node.Status.RetryStatus, time.Now())
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		it.eventRecorder.Event(&node, recorder.ChaosInjectFailed{
+			Name:  chaos.GetName(),
+			Kind:  chaos.GetObjectKind().GroupVersionKind().Kind,
+			Cause: cause,
+		})
+		retrying, backoff, err = recordFailure(ctx, it.kubeClient, it.eventRecorder, types.NamespacedName{
+			Namespace: node.Namespace,
+			Name:      node.Name,
+		}, v1alpha1.ChaosInjectFailed)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if !retrying {
+		// the chaos will be removed as the node is failed
+		return 0, nil
+	}
+
+	err = it.kubeClient.Delete(ctx, chaos)
+	if client.IgnoreNotFound(err) != nil {
+		it.logger.Error(err, "failed to delete chaos CR for retrying",
+			"namespace", node.Namespace,
+			"chaos node", node.Name,
+			"chaos CR name", chaos.GetName(),
+		)
+		it.eventRecorder.Event(&node, recorder.ChaosCustomResourceDeleteFailed{
+			Name: chaos.GetName(),
+			Kind: chaos.GetObjectKind().GroupVersionKind().Kind,
+		})
+		return 0, err
+	}
+	it.eventRecorder.Event(&node, recorder.ChaosCustomResourceDeleted{
+		Name: chaos.GetName(),
+		Kind: chaos.GetObjectKind().GroupVersionKind().Kind,
+	})
+	return backoff, nil
+}
+
+// chaosInjectionFailed returns true with the cause if any record of the chaos fails to be injected for
+// chaosApplyFailureThreshold times in a row.
+func chaosInjectionFailed(chaos v1alpha1.GenericChaos) (string, bool) {
+	statefulObject, ok := chaos.(v1alpha1.StatefulObject)
+	if !ok {
+		return "", false
+	}
+
+	for _, record := range statefulObject.GetStatus().Experiment.Records {
+		if record.Phase == v1alpha1.Injected || len(record.Events) < chaosApplyFailureThreshold {
+			continue
+		}
+
+		failed := true
+		for _, event := range record.Events[len(record.Events)-chaosApplyFailureThreshold:] {
+			if event.Type != v1alpha1.TypeFailed || event.Operation != v1alpha1.Apply {
+				failed = false
+				break
+			}
+		}
+		if failed {
+			return fmt.Sprintf("%s: %s", record.Id, record.Events[len(record.Events)-1].Message), true
+		}
+	}
+	return "", false
+}
+
+// createWithRetry creates the chaos or schedule of the node, and records the failure of creation with the retry
+// strategy of the node. It returns the backoff if the creation should be retried later.
+func (it *ChaosNodeReconciler) createWithRetry(ctx context.Context, node v1alpha1.WorkflowNode, create func(context.Context, v1alpha1.WorkflowNode) error) (time.Duration, error) {
+	if ConditionEqualsTo(node.Status, v1alpha1.ConditionFailed, corev1.ConditionTrue) {
+		// no more retries
+		return 0, nil
+	}
+
+	wait, err := retryWait(node.Spec.RetryStrategy, node.Status.RetryStatus, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if wait > 0 {
+		it.logger.V(4).Info("wait for the backoff before retrying",
+			"chaos node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"wait", wait)
+		return wait, nil
+	}
+
+	err = create(ctx, node)
+	if err == nil {
+		return 0, nil
+	}
+
+	retrying, backoff, err := recordFailure(ctx, it.kubeClient, it.eventRecorder, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Name,
+	}, v1alpha1.ChaosCRCreateFailed)
+	if err != nil {
+		return 0, err
+	}
+	if retrying {
+		return backoff, nil
+	}
+	return 0, nil
 }
 
 // inject Chaos will create one instance of chaos CR
@@ -323,7 +459,7 @@ func (it *ChaosNodeReconciler) createChaos(ctx context.Context, node v1alpha1.Wo
 	if err != nil {
 		it.eventRecorder.Event(&node, recorder.ChaosCustomResourceCreateFailed{})
 		it.logger.Error(err, "failed to create chaos")
-		return nil
+		return err
 	}
 	it.logger.Info("chaos object created", "namespace", chaosObject.GetNamespace(), "name", chaosObject.GetName(), "parent node", node)
 	it.eventRecorder.Event(&node, recorder.ChaosCustomResourceCreated{
@@ -391,7 +527,7 @@ func (it ChaosNodeReconciler) createSchedule(ctx context.Context, node v1alpha1.
 	if err != nil {
 		it.eventRecorder.Event(&node, recorder.ChaosCustomResourceCreateFailed{})
 		it.logger.Error(err, "failed to create schedule CR")
-		return nil
+		return err
 	}
 	it.logger.Info("schedule CR created", "namespace", scheduleToCreate.GetNamespace(), "name", scheduleToCreate.GetName())
 	it.eventRecorder.Event(&node, recorder.ChaosCustomResourceCreated{
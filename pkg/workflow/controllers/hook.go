@@ -0,0 +1,126 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+const (
+	HookOnFailure = "on-failure"
+	HookOnExit    = "on-exit"
+)
+
+// HookNodesSpawner spawns the OnFailure and OnExit hooks of the workflow node.
+//
+// The hook nodes are controlled by the node like the other children, but they are labeled with v1alpha1.LabelHook,
+// so they are omitted by ChildNodesFetcher and have no effects on the state of the node.
+type HookNodesSpawner struct {
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewHookNodesSpawner(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *HookNodesSpawner {
+	return &HookNodesSpawner{kubeClient: kubeClient, eventRecorder: eventRecorder, logger: logger}
+}
+
+// syncHookNodes spawns the OnFailure hook once the node is failed, and spawns the OnExit hook once the node is finished
+// or failed.
+// Each hook is spawned at most once, because the name of hook node is decided by the node and the type of hook.
+func (it *HookNodesSpawner) syncHookNodes(ctx context.Context, key types.NamespacedName) error {
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, key, &node)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	type hook struct {
+		hookType string
+		template string
+	}
+	var hooks []hook
+	if len(node.Spec.OnFailure) > 0 && WorkflowNodeFailed(node.Status) {
+		hooks = append(hooks, hook{hookType: HookOnFailure, template: node.Spec.OnFailure})
+	}
+	if len(node.Spec.OnExit) > 0 && (WorkflowNodeFinished(node.Status) || WorkflowNodeFailed(node.Status)) {
+		hooks = append(hooks, hook{hookType: HookOnExit, template: node.Spec.OnExit})
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	parentWorkflow := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Spec.WorkflowName,
+	}, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch parent workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"workflow name", node.Spec.WorkflowName)
+		return err
+	}
+
+	for _, item := range hooks {
+		hookType := item.hookType
+		outputs, err := fetchNodeOutputs(ctx, it.kubeClient, parentWorkflow, item.template)
+		if err != nil {
+			it.logger.Error(err, "failed to fetch outputs of nodes",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"hook", hookType)
+			return err
+		}
+		hookNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, item.template)
+		if err != nil {
+			it.logger.Error(err, "failed to render hook node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"hook", hookType)
+			return err
+		}
+
+		hookNode := hookNodes[0]
+		hookNode.GenerateName = ""
+		hookNode.Name = fmt.Sprintf("%s-%s", node.Name, hookType)
+		hookNode.Labels[v1alpha1.LabelHook] = hookType
+		err = it.kubeClient.Create(ctx, hookNode)
+		if apierrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			it.logger.Error(err, "failed to create hook node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"hook node", hookNode)
+			return err
+		}
+		it.eventRecorder.Event(&node, recorder.NodesCreated{ChildNodes: []string{hookNode.Name}})
+		it.logger.Info("spawn hook node",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"hook", hookType,
+			"hook node", hookNode.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,328 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/expr"
+)
+
+// LoopNodeReconciler watches on nodes which type is Loop
+type LoopNodeReconciler struct {
+	*ChildNodesFetcher
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewLoopNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *LoopNodeReconciler {
+	return &LoopNodeReconciler{
+		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		kubeClient:        kubeClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+// Reconcile should be invoked by: changes on a loop node, or changes on a node which controlled by loop node.
+//
+// A loop node works like a serial node which children are repeated: the children are spawned one by one in each
+// iteration, and the next iteration begins after the interval once the last child of current iteration finished.
+// The loop node is accomplished when the count of iterations is reached, or the until expression is evaluated as true.
+//
+// Like SerialNodeReconciler, the progress is decided by the children nodes observed from the real world. The only
+// exception is the finished time of the last iteration, which is recorded in v1alpha1.LoopStatus for the interval.
+func (it *LoopNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for loop node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve loop nodes
+	if node.Spec.Type != v1alpha1.TypeLoop {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve loop node", "node", request)
+
+	// make effects, create children nodes
+	requeueAfter, err := it.syncChildNodes(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// update status
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		activeChildren, finishedChildren, err := it.fetchChildNodes(ctx, nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		nodeNeedUpdate.Status.FinishedChildren = nil
+		for _, finishedChild := range finishedChildren {
+			nodeNeedUpdate.Status.FinishedChildren = append(nodeNeedUpdate.Status.FinishedChildren,
+				corev1.LocalObjectReference{
+					Name: finishedChild.Name,
+				})
+		}
+
+		nodeNeedUpdate.Status.ActiveChildren = nil
+		for _, activeChild := range activeChildren {
+			nodeNeedUpdate.Status.ActiveChildren = append(nodeNeedUpdate.Status.ActiveChildren,
+				corev1.LocalObjectReference{
+					Name: activeChild.Name,
+				})
+		}
+
+		finishedIterations := 0
+		if len(nodeNeedUpdate.Spec.Children) > 0 && len(activeChildren) == 0 {
+			finishedIterations = len(finishedChildren) / len(nodeNeedUpdate.Spec.Children)
+		}
+		if nodeNeedUpdate.Status.LoopStatus == nil {
+			nodeNeedUpdate.Status.LoopStatus = &v1alpha1.LoopStatus{}
+		}
+		if nodeNeedUpdate.Status.LoopStatus.FinishedIterations < finishedIterations {
+			now := metav1.NewTime(time.Now())
+			nodeNeedUpdate.Status.LoopStatus.FinishedIterations = finishedIterations
+			nodeNeedUpdate.Status.LoopStatus.LastIterationFinishedTime = &now
+		}
+
+		accomplished := len(nodeNeedUpdate.Spec.Children) == 0
+		if !accomplished && len(activeChildren) == 0 {
+			accomplished, err = loopFinished(nodeNeedUpdate.Spec.Loop, len(nodeNeedUpdate.Spec.Children), finishedChildren)
+			if err != nil {
+				// the loop stops without being accomplished, so the failure could be handled by the parent and hooks
+				it.logger.Error(err, "failed to evaluate the until expression, stop the loop",
+					"node", request.NamespacedName)
+				if !WorkflowNodeFailed(nodeNeedUpdate.Status) {
+					it.eventRecorder.Event(&nodeNeedUpdate, recorder.LoopUntilEvaluationFailed{
+						Until: nodeNeedUpdate.Spec.Loop.Until,
+						Cause: err.Error(),
+					})
+				}
+				SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+					Type:   v1alpha1.ConditionFailed,
+					Status: corev1.ConditionTrue,
+					Reason: v1alpha1.LoopUntilEvaluationFailed,
+				})
+			}
+		}
+
+		if accomplished {
+			if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+			}
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionTrue,
+				Reason: "",
+			})
+		} else {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionFalse,
+				Reason: "",
+			})
+		}
+
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+
+	if updateError != nil {
+		it.logger.Error(updateError, "failed to update the status of node", "node", request)
+		return reconcile.Result{}, updateError
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// syncChildNodes spawns the next child node of the loop node if there is no active child.
+// It returns a duration if the next iteration should wait for the interval.
+func (it *LoopNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) (time.Duration, error) {
+
+	// empty loop node
+	if len(node.Spec.Children) == 0 {
+		it.logger.V(4).Info("empty loop node, NOOP",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		)
+		return 0, nil
+	}
+
+	if WorkflowNodeFinished(node.Status) || WorkflowNodeFailed(node.Status) {
+		return 0, nil
+	}
+
+	activeChildNodes, finishedChildNodes, err := it.fetchChildNodes(ctx, node)
+	if err != nil {
+		return 0, err
+	}
+	if len(activeChildNodes) > 0 {
+		it.logger.V(4).Info("loop node has active child/children, skip scheduling",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"active children", activeChildNodes)
+		return 0, nil
+	}
+
+	finished, err := loopFinished(node.Spec.Loop, len(node.Spec.Children), finishedChildNodes)
+	if err != nil || finished {
+		// the status will be updated in Reconcile
+		return 0, nil
+	}
+
+	index := len(finishedChildNodes) % len(node.Spec.Children)
+	if index == 0 && len(finishedChildNodes) > 0 && node.Spec.Loop != nil && node.Spec.Loop.Interval != nil {
+		interval, err := time.ParseDuration(*node.Spec.Loop.Interval)
+		if err != nil {
+			it.logger.Error(err, "failed to parse the interval of loop node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"interval", *node.Spec.Loop.Interval)
+			return 0, err
+		}
+
+		loopStatus := node.Status.LoopStatus
+		finishedIterations := len(finishedChildNodes) / len(node.Spec.Children)
+		if loopStatus == nil || loopStatus.FinishedIterations < finishedIterations || loopStatus.LastIterationFinishedTime == nil {
+			// the finished time of last iteration is not recorded yet, the update of status will trigger another reconcile
+			return 0, nil
+		}
+		if wait := time.Until(loopStatus.LastIterationFinishedTime.Add(interval)); wait > 0 {
+			it.logger.V(4).Info("loop node is waiting for the next iteration",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"wait", wait)
+			return wait, nil
+		}
+	}
+	taskToStartup := node.Spec.Children[index]
+
+	parentWorkflow := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Spec.WorkflowName,
+	}, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch parent workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"workflow name", node.Spec.WorkflowName)
+		return 0, err
+	}
+	outputs, err := fetchNodeOutputs(ctx, it.kubeClient, parentWorkflow, taskToStartup)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of nodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return 0, err
+	}
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, taskToStartup)
+	if err != nil {
+		it.logger.Error(err, "failed to render children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return 0, err
+	}
+
+	var childrenNames []string
+	for _, childNode := range childNodes {
+		err := it.kubeClient.Create(ctx, childNode)
+		if err != nil {
+			it.logger.Error(err, "failed to create child node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"child node", childNode)
+			return 0, err
+		}
+		childrenNames = append(childrenNames, childNode.Name)
+	}
+	it.eventRecorder.Event(&node, recorder.NodesCreated{ChildNodes: childrenNames})
+	it.logger.Info("loop node spawn new child node",
+		"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		"child node", childrenNames)
+
+	return 0, nil
+}
+
+// loopFinished decides whether the loop should stop with the finished children nodes, which are sorted by the
+// creation timestamp. It is only checked at the end of each iteration, and an error is returned if the until
+// expression cannot be evaluated, then the loop node is marked as failed.
+func loopFinished(loop *v1alpha1.LoopSpec, bodyLength int, finishedChildNodes []v1alpha1.WorkflowNode) (bool, error) {
+	if loop == nil || bodyLength == 0 {
+		return true, nil
+	}
+	if len(finishedChildNodes) == 0 || len(finishedChildNodes)%bodyLength != 0 {
+		return false, nil
+	}
+
+	finishedIterations := len(finishedChildNodes) / bodyLength
+	if loop.Count != nil && finishedIterations >= *loop.Count {
+		return true, nil
+	}
+	if len(loop.Until) == 0 {
+		return false, nil
+	}
+
+	env, err := iterationEnv(finishedIterations, finishedChildNodes[len(finishedChildNodes)-bodyLength:])
+	if err != nil {
+		return false, err
+	}
+	until, err := expr.EvalBool(loop.Until, env)
+	if err != nil {
+		return false, errors.Wrapf(err, "evaluate expression %s", loop.Until)
+	}
+	return until, nil
+}
+
+// iterationEnv builds the env for evaluating the until expression, with the context of the last Task in the iteration.
+func iterationEnv(finishedIterations int, iteration []v1alpha1.WorkflowNode) (map[string]interface{}, error) {
+	env := make(map[string]interface{})
+	for i := len(iteration) - 1; i >= 0; i-- {
+		status := iteration[i].Status.ConditionalBranchesStatus
+		if status != nil && len(status.Context) > 0 {
+			err := json.Unmarshal([]byte(status.Context[0]), &env)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unmarshal context of node %s", iteration[i].Name)
+			}
+			break
+		}
+	}
+	env["iteration"] = finishedIterations
+	return env, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+
+	"k8s.io/utils/pointer"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// unit tests
+func Test_loopFinished(t *testing.T) {
+	taskNode := func(context string) v1alpha1.WorkflowNode {
+		return v1alpha1.WorkflowNode{
+			Status: v1alpha1.WorkflowNodeStatus{
+				ConditionalBranchesStatus: &v1alpha1.ConditionalBranchesStatus{
+					Context: []string{context},
+				},
+			},
+		}
+	}
+	type args struct {
+		loop               *v1alpha1.LoopSpec
+		bodyLength         int
+		finishedChildNodes []v1alpha1.WorkflowNode
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "no finished iteration",
+			args: args{
+				loop:               &v1alpha1.LoopSpec{Count: pointer.IntPtr(1)},
+				bodyLength:         2,
+				finishedChildNodes: []v1alpha1.WorkflowNode{{}},
+			},
+			want: false,
+		}, {
+			name: "count reached",
+			args: args{
+				loop:               &v1alpha1.LoopSpec{Count: pointer.IntPtr(2)},
+				bodyLength:         2,
+				finishedChildNodes: []v1alpha1.WorkflowNode{{}, {}, {}, {}},
+			},
+			want: true,
+		}, {
+			name: "count not reached",
+			args: args{
+				loop:               &v1alpha1.LoopSpec{Count: pointer.IntPtr(3)},
+				bodyLength:         2,
+				finishedChildNodes: []v1alpha1.WorkflowNode{{}, {}, {}, {}},
+			},
+			want: false,
+		}, {
+			name: "until with iteration",
+			args: args{
+				loop:               &v1alpha1.LoopSpec{Until: "iteration >= 2"},
+				bodyLength:         1,
+				finishedChildNodes: []v1alpha1.WorkflowNode{{}, {}},
+			},
+			want: true,
+		}, {
+			name: "until with the context of last task",
+			args: args{
+				loop:       &v1alpha1.LoopSpec{Until: "exitCode == 0"},
+				bodyLength: 2,
+				finishedChildNodes: []v1alpha1.WorkflowNode{
+					taskNode(`{"exitCode":1}`), {},
+					taskNode(`{"exitCode":0}`), {},
+				},
+			},
+			want: true,
+		}, {
+			name: "until is not satisfied",
+			args: args{
+				loop:       &v1alpha1.LoopSpec{Count: pointer.IntPtr(5), Until: "exitCode == 0"},
+				bodyLength: 1,
+				finishedChildNodes: []v1alpha1.WorkflowNode{
+					taskNode(`{"exitCode":0}`),
+					taskNode(`{"exitCode":1}`),
+				},
+			},
+			want: false,
+		}, {
+			name: "invalid until fails the loop",
+			args: args{
+				loop:               &v1alpha1.LoopSpec{Until: "iteration"},
+				bodyLength:         1,
+				finishedChildNodes: []v1alpha1.WorkflowNode{{}},
+			},
+			want:    false,
+			wantErr: true,
+		}, {
+			name: "until with an undefined variable fails the loop",
+			args: args{
+				loop:               &v1alpha1.LoopSpec{Until: "stdout contains \"done\""},
+				bodyLength:         1,
+				finishedChildNodes: []v1alpha1.WorkflowNode{{}},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loopFinished(tt.args.loop, tt.args.bodyLength, tt.args.finishedChildNodes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loopFinished() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("loopFinished() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -34,14 +34,32 @@ var (
 )
 
 // renderNodesByTemplates will render the nodes one by one, will setup owner by given parent. If parent is nil, it will use workflow as its owner.
-func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
+// The outputs of the finished nodes are indexed by template name, they are used to substitute the output references in the templates.
+func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, outputs map[string]map[string]string, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
 	templateNameSet := make(map[string]v1alpha1.Template)
 	for _, template := range workflow.Spec.Templates {
 		templateNameSet[template.Name] = template
 	}
+	parameters, err := workflow.Spec.ResolveParameters()
+	if err != nil {
+		return nil, errors.Wrapf(err, "resolve parameters of workflow %s", workflow.Name)
+	}
 	var result []*v1alpha1.WorkflowNode
 	for _, name := range templates {
 		if template, ok := templateNameSet[name]; ok {
+			if template.Type == v1alpha1.TypeTemplateRef {
+				return nil, errors.Errorf("template %s of workflow %s references a WorkflowTemplate which is not resolved", name, workflow.Name)
+			}
+
+			// substitute the parameters at node-creation time, so the nodes always use the arguments of the workflow
+			template, err := v1alpha1.RenderTemplate(template, parameters)
+			if err != nil {
+				return nil, errors.Wrapf(err, "render template %s of workflow %s", name, workflow.Name)
+			}
+			template, err = v1alpha1.RenderOutputs(template, outputs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "render outputs in template %s of workflow %s", name, workflow.Name)
+			}
 
 			now := metav1.NewTime(time.Now())
 			var deadline *metav1.Time = nil
@@ -68,12 +86,16 @@ func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.Workfl
 					StartTime:            &now,
 					Deadline:             deadline,
 					Children:             template.Children,
+					Loop:                 template.Loop,
 					Task:                 template.Task,
 					ConditionalBranches:  template.ConditionalBranches,
 					EmbedChaos:           template.EmbedChaos,
 					Schedule:             conversionSchedule(template.Schedule),
 					StatusCheck:          template.StatusCheck,
 					AbortWithStatusCheck: template.AbortWithStatusCheck,
+					RetryStrategy:        template.RetryStrategy,
+					OnFailure:            template.OnFailure,
+					OnExit:               template.OnExit,
 				},
 			}
 
@@ -0,0 +1,116 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task/collector"
+)
+
+const (
+	// OutputStatusCheckOutcome is the outcome of the last execution of the status check
+	OutputStatusCheckOutcome = "outcome"
+	// OutputStatusCheckCount is the total number of the executions of the status check
+	OutputStatusCheckCount = "count"
+)
+
+// taskOutputs converts the env collected from the task pod to the outputs of the node.
+func taskOutputs(env map[string]interface{}) map[string]string {
+	if len(env) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(env))
+	for key, value := range env {
+		result[key] = fmt.Sprint(value)
+	}
+	// the trailing newline of stdout is useless when the output is referenced by other templates
+	if stdout, ok := result[collector.Stdout]; ok {
+		result[collector.Stdout] = strings.TrimSpace(stdout)
+	}
+	return result
+}
+
+// statusCheckOutputs returns the outputs of the node from the status of the StatusCheck.
+func statusCheckOutputs(statusCheck v1alpha1.StatusCheck) map[string]string {
+	result := map[string]string{
+		OutputStatusCheckCount: strconv.FormatInt(statusCheck.Status.Count, 10),
+	}
+	if records := statusCheck.Status.Records; len(records) > 0 {
+		result[OutputStatusCheckOutcome] = string(records[len(records)-1].Outcome)
+	}
+	return result
+}
+
+// collectOutputs indexes the outputs of the nodes by their template names, if there are several nodes
+// with the same template (e.g. in a loop), the latest created one is used.
+func collectOutputs(nodes []v1alpha1.WorkflowNode) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	latest := make(map[string]v1alpha1.WorkflowNode)
+	for _, node := range nodes {
+		if len(node.Status.Outputs) == 0 {
+			continue
+		}
+		if previous, ok := latest[node.Spec.TemplateName]; ok && node.CreationTimestamp.Before(&previous.CreationTimestamp) {
+			continue
+		}
+		latest[node.Spec.TemplateName] = node
+		result[node.Spec.TemplateName] = node.Status.Outputs
+	}
+	return result
+}
+
+// fetchNodeOutputs returns the outputs of the nodes in the workflow, which are referenced by the given templates.
+func fetchNodeOutputs(ctx context.Context, kubeClient client.Client, workflow v1alpha1.Workflow, templates ...string) (map[string]map[string]string, error) {
+	toRender := make(map[string]struct{})
+	for _, name := range templates {
+		toRender[name] = struct{}{}
+	}
+
+	referenced := false
+	for _, template := range workflow.Spec.Templates {
+		if _, ok := toRender[template.Name]; !ok {
+			continue
+		}
+		names, err := v1alpha1.OutputReferences(template)
+		if err != nil {
+			return nil, err
+		}
+		if len(names) > 0 {
+			referenced = true
+			break
+		}
+	}
+	if !referenced {
+		return nil, nil
+	}
+
+	nodes := v1alpha1.WorkflowNodeList{}
+	err := kubeClient.List(ctx, &nodes, client.InNamespace(workflow.Namespace), client.MatchingLabels{
+		v1alpha1.LabelWorkflow: workflow.Name,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "list nodes of workflow %s", workflow.Name)
+	}
+	return collectOutputs(nodes.Items), nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// unit tests
+func Test_taskOutputs(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]interface{}
+		want map[string]string
+	}{
+		{
+			name: "nothing collected",
+			env:  nil,
+			want: nil,
+		}, {
+			name: "exit code and stdout",
+			env:  map[string]interface{}{"exitCode": 0, "stdout": "tikv-1\n"},
+			want: map[string]string{"exitCode": "0", "stdout": "tikv-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := taskOutputs(tt.env); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("taskOutputs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_collectOutputs(t *testing.T) {
+	now := time.Now()
+	node := func(template string, created time.Time, outputs map[string]string) v1alpha1.WorkflowNode {
+		return v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+			Spec:       v1alpha1.WorkflowNodeSpec{TemplateName: template},
+			Status:     v1alpha1.WorkflowNodeStatus{Outputs: outputs},
+		}
+	}
+	tests := []struct {
+		name  string
+		nodes []v1alpha1.WorkflowNode
+		want  map[string]map[string]string
+	}{
+		{
+			name: "nodes without outputs are ignored",
+			nodes: []v1alpha1.WorkflowNode{
+				node("find-leader", now, map[string]string{"stdout": "tikv-1"}),
+				node("partition-leader", now, nil),
+			},
+			want: map[string]map[string]string{
+				"find-leader": {"stdout": "tikv-1"},
+			},
+		}, {
+			name: "the latest node is used",
+			nodes: []v1alpha1.WorkflowNode{
+				node("find-leader", now, map[string]string{"stdout": "tikv-2"}),
+				node("find-leader", now.Add(-time.Minute), map[string]string{"stdout": "tikv-1"}),
+			},
+			want: map[string]map[string]string{
+				"find-leader": {"stdout": "tikv-2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectOutputs(tt.nodes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("collectOutputs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_renderNodesByTemplatesWithOutputs(t *testing.T) {
+	deadline := "{{nodes.find-leader.outputs.stdout}}"
+	workflow := v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "partition"},
+		Spec: v1alpha1.WorkflowSpec{
+			Templates: []v1alpha1.Template{
+				{Name: "wait", Type: v1alpha1.TypeSuspend, Deadline: &deadline},
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		outputs  map[string]map[string]string
+		deadline time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "outputs are substituted",
+			outputs:  map[string]map[string]string{"find-leader": {"stdout": "30s"}},
+			deadline: 30 * time.Second,
+		}, {
+			name:    "outputs are not available",
+			outputs: nil,
+			wantErr: true,
+		}, {
+			name:    "output key does not exist",
+			outputs: map[string]map[string]string{"find-leader": {"exitCode": "0"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, err := renderNodesByTemplates(&workflow, nil, tt.outputs, "wait")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("renderNodesByTemplates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			spec := nodes[0].Spec
+			if got := spec.Deadline.Sub(spec.StartTime.Time); got != tt.deadline {
+				t.Errorf("renderNodesByTemplates() deadline = %v, want %v", got, tt.deadline)
+			}
+		})
+	}
+}
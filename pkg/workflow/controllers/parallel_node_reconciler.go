@@ -34,6 +34,7 @@ import (
 // ParallelNodeReconciler watches on nodes which type is Parallel
 type ParallelNodeReconciler struct {
 	*ChildNodesFetcher
+	*HookNodesSpawner
 	kubeClient    client.Client
 	eventRecorder recorder.ChaosRecorder
 	logger        logr.Logger
@@ -42,6 +43,7 @@ type ParallelNodeReconciler struct {
 func NewParallelNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *ParallelNodeReconciler {
 	return &ParallelNodeReconciler{
 		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		HookNodesSpawner:  NewHookNodesSpawner(kubeClient, eventRecorder, logger),
 		kubeClient:        kubeClient,
 		eventRecorder:     eventRecorder,
 		logger:            logger,
@@ -124,6 +126,18 @@ func (it *ParallelNodeReconciler) Reconcile(ctx context.Context, request reconci
 			})
 		}
 
+		// the node fails once any of its children fails, so the OnFailure hook of the node could be spawned
+		if failedChildName, failed := failedChildNode(activeChildren, finishedChildren); failed {
+			if !WorkflowNodeFailed(nodeNeedUpdate.Status) {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.ChildNodeFailed{ChildNodeName: failedChildName})
+			}
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.ChildNodeFailed,
+			})
+		}
+
 		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
 	})
 
@@ -132,7 +146,7 @@ func (it *ParallelNodeReconciler) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, updateError
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, it.syncHookNodes(ctx, request.NamespacedName)
 }
 
 func (it *ParallelNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) error {
@@ -206,7 +220,13 @@ func (it *ParallelNodeReconciler) syncChildNodes(ctx context.Context, node v1alp
 		return err
 	}
 
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, tasksToStartup...)
+	outputs, err := fetchNodeOutputs(ctx, it.kubeClient, parentWorkflow, tasksToStartup...)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of nodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, tasksToStartup...)
 	if err != nil {
 		it.logger.Error(err, "failed to render children childNodes",
 			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
@@ -0,0 +1,130 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// retryBackoff returns the duration to wait before the given retry, the backoff is doubled for each retry.
+func retryBackoff(strategy *v1alpha1.RetryStrategy, retries int) (time.Duration, error) {
+	if strategy == nil || strategy.Backoff == nil {
+		return 0, nil
+	}
+	backoff, err := time.ParseDuration(*strategy.Backoff)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse backoff %s", *strategy.Backoff)
+	}
+	for i := 1; i < retries && backoff <= math.MaxInt64/2; i++ {
+		backoff *= 2
+	}
+	return backoff, nil
+}
+
+// couldRetry returns true if the failed node could be retried with the retry strategy.
+func couldRetry(strategy *v1alpha1.RetryStrategy, status *v1alpha1.RetryStatus) bool {
+	if strategy == nil {
+		return false
+	}
+	retries := 0
+	if status != nil {
+		retries = status.Retries
+	}
+	return retries < strategy.Limit
+}
+
+// retryWait returns the duration to wait before the next retry of the node.
+func retryWait(strategy *v1alpha1.RetryStrategy, status *v1alpha1.RetryStatus, now time.Time) (time.Duration, error) {
+	if status == nil || status.LastFailureTime == nil {
+		return 0, nil
+	}
+	backoff, err := retryBackoff(strategy, status.Retries)
+	if err != nil {
+		return 0, err
+	}
+	return status.LastFailureTime.Add(backoff).Sub(now), nil
+}
+
+// failureRecorded returns true if a failure has been recorded since the given time, so the failure of the pod or chaos
+// created at that time has been recorded, and the retry is scheduled.
+func failureRecorded(status *v1alpha1.RetryStatus, since metav1.Time) bool {
+	if status == nil || status.LastFailureTime == nil {
+		return false
+	}
+	return !status.LastFailureTime.Before(&since)
+}
+
+// recordFailure records the failure of the node. If the node could be retried, a retry is scheduled and it returns
+// true with the backoff of the retry, otherwise the node is marked as Failed with the reason.
+func recordFailure(ctx context.Context, kubeClient client.Client, eventRecorder recorder.ChaosRecorder, key types.NamespacedName, reason string) (bool, time.Duration, error) {
+	retrying := false
+	var backoff time.Duration
+	nodeNeedUpdate := v1alpha1.WorkflowNode{}
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := kubeClient.Get(ctx, key, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		retrying = couldRetry(nodeNeedUpdate.Spec.RetryStrategy, nodeNeedUpdate.Status.RetryStatus)
+		if retrying {
+			if nodeNeedUpdate.Status.RetryStatus == nil {
+				nodeNeedUpdate.Status.RetryStatus = &v1alpha1.RetryStatus{}
+			}
+			now := metav1.NewTime(time.Now())
+			nodeNeedUpdate.Status.RetryStatus.Retries++
+			nodeNeedUpdate.Status.RetryStatus.LastFailureTime = &now
+
+			backoff, err = retryBackoff(nodeNeedUpdate.Spec.RetryStrategy, nodeNeedUpdate.Status.RetryStatus.Retries)
+			if err != nil {
+				return err
+			}
+		} else {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: reason,
+			})
+		}
+
+		return kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+	if updateError != nil {
+		return false, 0, updateError
+	}
+
+	if retrying {
+		eventRecorder.Event(&nodeNeedUpdate, recorder.RetryScheduled{
+			Retries: nodeNeedUpdate.Status.RetryStatus.Retries,
+			Backoff: backoff.String(),
+		})
+	} else {
+		eventRecorder.Event(&nodeNeedUpdate, recorder.NodeFailed{Cause: reason})
+	}
+	return retrying, backoff, nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// unit tests
+func Test_retryBackoff(t *testing.T) {
+	type args struct {
+		strategy *v1alpha1.RetryStrategy
+		retries  int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "no retry strategy",
+			args: args{strategy: nil, retries: 1},
+			want: 0,
+		}, {
+			name: "no backoff",
+			args: args{strategy: &v1alpha1.RetryStrategy{Limit: 3}, retries: 1},
+			want: 0,
+		}, {
+			name: "first retry",
+			args: args{strategy: &v1alpha1.RetryStrategy{Limit: 3, Backoff: pointer.StringPtr("10s")}, retries: 1},
+			want: 10 * time.Second,
+		}, {
+			name: "backoff is doubled",
+			args: args{strategy: &v1alpha1.RetryStrategy{Limit: 3, Backoff: pointer.StringPtr("10s")}, retries: 3},
+			want: 40 * time.Second,
+		}, {
+			name:    "invalid backoff",
+			args:    args{strategy: &v1alpha1.RetryStrategy{Limit: 3, Backoff: pointer.StringPtr("10")}, retries: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := retryBackoff(tt.args.strategy, tt.args.retries)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("retryBackoff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("retryBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_couldRetry(t *testing.T) {
+	type args struct {
+		strategy *v1alpha1.RetryStrategy
+		status   *v1alpha1.RetryStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no retry strategy",
+			args: args{strategy: nil, status: nil},
+			want: false,
+		}, {
+			name: "first failure",
+			args: args{strategy: &v1alpha1.RetryStrategy{Limit: 1}, status: nil},
+			want: true,
+		}, {
+			name: "limit is zero",
+			args: args{strategy: &v1alpha1.RetryStrategy{Limit: 0}, status: nil},
+			want: false,
+		}, {
+			name: "retries are exhausted",
+			args: args{strategy: &v1alpha1.RetryStrategy{Limit: 2}, status: &v1alpha1.RetryStatus{Retries: 2}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := couldRetry(tt.args.strategy, tt.args.status); got != tt.want {
+				t.Errorf("couldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_retryWait(t *testing.T) {
+	now := time.Now()
+	lastFailureTime := metav1.NewTime(now.Add(-15 * time.Second))
+	strategy := &v1alpha1.RetryStrategy{Limit: 3, Backoff: pointer.StringPtr("10s")}
+	type args struct {
+		status *v1alpha1.RetryStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want time.Duration
+	}{
+		{
+			name: "never failed",
+			args: args{status: nil},
+			want: 0,
+		}, {
+			name: "backoff elapsed",
+			args: args{status: &v1alpha1.RetryStatus{Retries: 1, LastFailureTime: &lastFailureTime}},
+			want: -5 * time.Second,
+		}, {
+			name: "wait for the backoff",
+			args: args{status: &v1alpha1.RetryStatus{Retries: 2, LastFailureTime: &lastFailureTime}},
+			want: 5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := retryWait(strategy, tt.args.status, now)
+			if err != nil {
+				t.Errorf("retryWait() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("retryWait() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_failureRecorded(t *testing.T) {
+	now := time.Now()
+	lastFailureTime := metav1.NewTime(now)
+	tests := []struct {
+		name   string
+		status *v1alpha1.RetryStatus
+		since  metav1.Time
+		want   bool
+	}{
+		{
+			name:   "never failed",
+			status: nil,
+			since:  metav1.NewTime(now),
+			want:   false,
+		}, {
+			name:   "failure of the current pod is recorded",
+			status: &v1alpha1.RetryStatus{Retries: 1, LastFailureTime: &lastFailureTime},
+			since:  metav1.NewTime(now.Add(-10 * time.Second)),
+			want:   true,
+		}, {
+			name:   "pod is created after the last failure",
+			status: &v1alpha1.RetryStatus{Retries: 1, LastFailureTime: &lastFailureTime},
+			since:  metav1.NewTime(now.Add(10 * time.Second)),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := failureRecorded(tt.status, tt.since); got != tt.want {
+				t.Errorf("failureRecorded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_chaosInjectionFailed(t *testing.T) {
+	now := metav1.Now()
+	failed := v1alpha1.RecordEvent{Type: v1alpha1.TypeFailed, Operation: v1alpha1.Apply, Message: "container not found", Timestamp: &now}
+	succeeded := v1alpha1.RecordEvent{Type: v1alpha1.TypeSucceeded, Operation: v1alpha1.Apply, Timestamp: &now}
+	chaosWithEvents := func(phase v1alpha1.Phase, events ...v1alpha1.RecordEvent) v1alpha1.GenericChaos {
+		chaos := &v1alpha1.PodChaos{}
+		chaos.Status.Experiment.Records = []*v1alpha1.Record{
+			{Id: "default/foo", Phase: phase, Events: events},
+		}
+		return chaos
+	}
+	tests := []struct {
+		name      string
+		chaos     v1alpha1.GenericChaos
+		wantCause string
+		want      bool
+	}{
+		{
+			name:  "not injected yet",
+			chaos: chaosWithEvents(v1alpha1.NotInjected),
+			want:  false,
+		}, {
+			name:  "injected",
+			chaos: chaosWithEvents(v1alpha1.Injected, failed, failed, failed, succeeded),
+			want:  false,
+		}, {
+			name:  "failed less than the threshold",
+			chaos: chaosWithEvents(v1alpha1.NotInjected, failed, failed),
+			want:  false,
+		}, {
+			name:  "succeeded between the failures",
+			chaos: chaosWithEvents(v1alpha1.NotInjected, failed, failed, succeeded, failed),
+			want:  false,
+		}, {
+			name:      "failed in a row",
+			chaos:     chaosWithEvents(v1alpha1.NotInjected, succeeded, failed, failed, failed),
+			wantCause: "default/foo: container not found",
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cause, got := chaosInjectionFailed(tt.chaos)
+			if got != tt.want || cause != tt.wantCause {
+				t.Errorf("chaosInjectionFailed() = %v, %v, want %v, %v", cause, got, tt.wantCause, tt.want)
+			}
+		})
+	}
+}
+
+func Test_failedChildNode(t *testing.T) {
+	failedNode := v1alpha1.WorkflowNode{}
+	failedNode.Name = "failed"
+	failedNode.Status.Conditions = []v1alpha1.WorkflowNodeCondition{
+		{Type: v1alpha1.ConditionFailed, Status: corev1.ConditionTrue, Reason: v1alpha1.TaskPodFailed},
+	}
+	runningNode := v1alpha1.WorkflowNode{}
+	runningNode.Name = "running"
+
+	if name, failed := failedChildNode([]v1alpha1.WorkflowNode{runningNode}, nil); failed {
+		t.Errorf("failedChildNode() = %v, %v, want no failed node", name, failed)
+	}
+	if name, failed := failedChildNode([]v1alpha1.WorkflowNode{runningNode, failedNode}, nil); !failed || name != "failed" {
+		t.Errorf("failedChildNode() = %v, %v, want failed, true", name, failed)
+	}
+}
@@ -35,6 +35,7 @@ import (
 // SerialNodeReconciler watches on nodes which type is Serial
 type SerialNodeReconciler struct {
 	*ChildNodesFetcher
+	*HookNodesSpawner
 	kubeClient    client.Client
 	eventRecorder recorder.ChaosRecorder
 	logger        logr.Logger
@@ -43,6 +44,7 @@ type SerialNodeReconciler struct {
 func NewSerialNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *SerialNodeReconciler {
 	return &SerialNodeReconciler{
 		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		HookNodesSpawner:  NewHookNodesSpawner(kubeClient, eventRecorder, logger),
 		kubeClient:        kubeClient,
 		eventRecorder:     eventRecorder,
 		logger:            logger,
@@ -144,6 +146,18 @@ func (it *SerialNodeReconciler) Reconcile(ctx context.Context, request reconcile
 			})
 		}
 
+		// the node fails once any of its children fails, so the OnFailure hook of the node could be spawned
+		if failedChildName, failed := failedChildNode(activeChildren, finishedChildren); failed {
+			if !WorkflowNodeFailed(nodeNeedUpdate.Status) {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.ChildNodeFailed{ChildNodeName: failedChildName})
+			}
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.ChildNodeFailed,
+			})
+		}
+
 		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
 	})
 
@@ -152,7 +166,7 @@ func (it *SerialNodeReconciler) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{}, updateError
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, it.syncHookNodes(ctx, request.NamespacedName)
 }
 
 // syncChildNodes reconciles the children nodes to following the desired states.
@@ -262,7 +276,13 @@ func (it *SerialNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha
 		return err
 	}
 	// TODO: using ordered id instead of random suffix is better, like StatefulSet, also related to the sorting
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, taskToStartup)
+	outputs, err := fetchNodeOutputs(ctx, it.kubeClient, parentWorkflow, taskToStartup)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of nodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, taskToStartup)
 	if err != nil {
 		it.logger.Error(err, "failed to render children childNodes",
 			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
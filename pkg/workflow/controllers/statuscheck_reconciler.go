@@ -160,6 +160,7 @@ func (it *StatusCheckReconciler) updateNodeStatus(ctx context.Context, request r
 
 		statusCheck := statusChecks[0]
 		if statusCheck.IsCompleted() {
+			node.Status.Outputs = statusCheckOutputs(statusCheck)
 			SetCondition(&node.Status, v1alpha1.WorkflowNodeCondition{
 				Type:   v1alpha1.ConditionAccomplished,
 				Status: corev1.ConditionTrue,
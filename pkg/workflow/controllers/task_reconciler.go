@@ -39,6 +39,7 @@ import (
 
 type TaskReconciler struct {
 	*ChildNodesFetcher
+	*HookNodesSpawner
 	kubeClient    client.Client
 	restConfig    *rest.Config
 	eventRecorder recorder.ChaosRecorder
@@ -48,6 +49,7 @@ type TaskReconciler struct {
 func NewTaskReconciler(kubeClient client.Client, restConfig *rest.Config, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *TaskReconciler {
 	return &TaskReconciler{
 		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		HookNodesSpawner:  NewHookNodesSpawner(kubeClient, eventRecorder, logger),
 		kubeClient:        kubeClient,
 		restConfig:        restConfig,
 		eventRecorder:     eventRecorder,
@@ -84,6 +86,15 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 	}
 
 	if len(pods) == 0 {
+		wait, err := retryWait(node.Spec.RetryStrategy, node.Status.RetryStatus, time.Now())
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if wait > 0 {
+			it.logger.V(4).Info("wait for the backoff before retrying the task", "node", request, "wait", wait)
+			return reconcile.Result{RequeueAfter: wait}, nil
+		}
+
 		if workflowName, ok := node.Labels[v1alpha1.LabelWorkflow]; ok {
 			parentWorkflow := v1alpha1.Workflow{}
 			err := it.kubeClient.Get(ctx, types.NamespacedName{
@@ -118,6 +129,33 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 		)
 	}
 
+	// retry the failed task, or mark the node as Failed if there are no more retries
+	if len(pods) > 0 && pods[0].Status.Phase == corev1.PodFailed && !WorkflowNodeFailed(node.Status) {
+		var backoff time.Duration
+		retrying := true
+		if failureRecorded(node.Status.RetryStatus, pods[0].CreationTimestamp) {
+			// the failure has been recorded, but the pod is not removed yet
+			backoff, err = retryWait(node.Spec.RetryStrategy, node.Status.RetryStatus, time.Now())
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+		} else {
+			it.eventRecorder.Event(&node, recorder.TaskPodFailed{PodName: pods[0].Name})
+			retrying, backoff, err = recordFailure(ctx, it.kubeClient, it.eventRecorder, request.NamespacedName, v1alpha1.TaskPodFailed)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+		if retrying {
+			// remove the failed pod, a new one will be spawned after the backoff
+			err := it.kubeClient.Delete(ctx, &pods[0])
+			if client.IgnoreNotFound(err) != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{RequeueAfter: backoff}, nil
+		}
+	}
+
 	// update the status about conditional tasks
 	if len(pods) > 0 && (pods[0].Status.Phase == corev1.PodFailed || pods[0].Status.Phase == corev1.PodSucceeded) {
 		evaluated, err := it.conditionalBranchesEvaluated(ctx, node)
@@ -157,6 +195,7 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 						nodeNeedUpdate.Status.ConditionalBranchesStatus.Context = []string{string(jsonString)}
 					}
 				}
+				nodeNeedUpdate.Status.Outputs = taskOutputs(env)
 
 				evaluator := task.NewEvaluator(it.logger, it.kubeClient)
 				evaluateConditionBranches, err := evaluator.EvaluateConditionBranches(nodeNeedUpdate.Spec.ConditionalBranches, env)
@@ -298,10 +337,12 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 			return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
 		})
 
-		return reconcile.Result{}, client.IgnoreNotFound(updateError)
+		if updateError != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(updateError)
+		}
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, it.syncHookNodes(ctx, request.NamespacedName)
 
 }
 
@@ -371,7 +412,13 @@ func (it *TaskReconciler) syncChildNodes(ctx context.Context, evaluatedNode v1al
 		return err
 	}
 
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &evaluatedNode, tasks...)
+	outputs, err := fetchNodeOutputs(ctx, it.kubeClient, parentWorkflow, tasks...)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of nodes",
+			"node", fmt.Sprintf("%s/%s", evaluatedNode.Namespace, evaluatedNode.Name))
+		return err
+	}
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &evaluatedNode, outputs, tasks...)
 	if err != nil {
 		it.logger.Error(err, "failed to render children childNodes",
 			"node", fmt.Sprintf("%s/%s", evaluatedNode.Namespace, evaluatedNode.Name))
@@ -419,7 +466,15 @@ func (it *TaskReconciler) FetchPodControlledByThisWorkflowNode(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	return childPods.Items, nil
+
+	// the pods being deleted are the failed ones which have been retried
+	var result []corev1.Pod
+	for _, pod := range childPods.Items {
+		if pod.DeletionTimestamp == nil {
+			result = append(result, pod)
+		}
+	}
+	return result, nil
 }
 
 func (it *TaskReconciler) SpawnTaskPod(ctx context.Context, node *v1alpha1.WorkflowNode, workflow *v1alpha1.Workflow) (*corev1.Pod, error) {
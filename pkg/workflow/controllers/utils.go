@@ -72,6 +72,23 @@ func WorkflowNodeFinished(status v1alpha1.WorkflowNodeStatus) bool {
 		ConditionEqualsTo(status, v1alpha1.ConditionAborted, corev1.ConditionTrue)
 }
 
+// WorkflowNodeFailed returns true if the node failed and there are no more retries.
+func WorkflowNodeFailed(status v1alpha1.WorkflowNodeStatus) bool {
+	return ConditionEqualsTo(status, v1alpha1.ConditionFailed, corev1.ConditionTrue)
+}
+
+// failedChildNode returns the name of the first failed node in the children.
+func failedChildNode(children ...[]v1alpha1.WorkflowNode) (string, bool) {
+	for _, nodes := range children {
+		for _, node := range nodes {
+			if WorkflowNodeFailed(node.Status) {
+				return node.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
 func WorkflowAborted(workflow v1alpha1.Workflow) bool {
 	return workflow.Annotations[v1alpha1.WorkflowAnnotationAbort] == "true"
 }
@@ -136,14 +153,20 @@ func NewChildNodesFetcher(kubeClient client.Client, logger logr.Logger) *ChildNo
 	return &ChildNodesFetcher{kubeClient: kubeClient, logger: logger}
 }
 
-// fetchChildNodes will return children workflow nodes controlled by given node
-// Should only be used with Parallel and Serial Node
+// fetchChildNodes will return children workflow nodes controlled by given node, the hook nodes are omitted.
+// Should only be used with Parallel, Serial and Loop Node
 func (it *ChildNodesFetcher) fetchChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) (activeChildNodes []v1alpha1.WorkflowNode, finishedChildNodes []v1alpha1.WorkflowNode, err error) {
 	childNodes := v1alpha1.WorkflowNodeList{}
 	controlledByThisNode, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
 		MatchLabels: map[string]string{
 			v1alpha1.LabelControlledBy: node.Name,
 		},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      v1alpha1.LabelHook,
+				Operator: metav1.LabelSelectorOpDoesNotExist,
+			},
+		},
 	})
 
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/templateref"
 )
 
 // WorkflowEntryReconciler watches on Workflow, creates new Entry Node for created Workflow.
@@ -68,6 +70,23 @@ func (it *WorkflowEntryReconciler) Reconcile(ctx context.Context, request reconc
 	}
 
 	if len(entryNodes) == 0 {
+		resolved, err := it.resolveTemplateRefs(ctx, &workflow)
+		if err != nil {
+			it.eventRecorder.Event(&workflow, recorder.InvalidTemplateRef{Cause: err.Error()})
+			it.logger.Error(err, "failed to resolve the template references of workflow",
+				"workflow", request.NamespacedName)
+			if updateError := it.markTemplateRefInvalid(ctx, request.NamespacedName); updateError != nil {
+				it.logger.Error(updateError, "failed to update the conditions of workflow",
+					"workflow", request.NamespacedName)
+			}
+			// the referenced libraries are not watched, so requeue with backoff until they could be resolved
+			return reconcile.Result{}, err
+		}
+		if resolved {
+			// the update of workflow will trigger another reconcile, the entry node would be spawned then
+			return reconcile.Result{}, nil
+		}
+
 		func() {
 			// Not scheduled yet, spawn the entry workflow node
 			spawnedEntryNode, err := it.spawnEntryNode(ctx, workflow)
@@ -231,10 +250,53 @@ func fetchEntryNode(ctx context.Context, kubeClient client.Client, workflow v1al
 	return sortedEntryNodes, nil
 }
 
+// resolveTemplateRefs replaces the templates with type TemplateRef by the referenced templates and the templates they
+// depend on, and updates the workflow. It returns true if the workflow is updated.
+func (it *WorkflowEntryReconciler) resolveTemplateRefs(ctx context.Context, workflow *v1alpha1.Workflow) (bool, error) {
+	resolvedTemplates, err := templateref.Resolve(ctx, it.kubeClient, workflow)
+	if err != nil {
+		return false, err
+	}
+	if resolvedTemplates == nil {
+		return false, nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		workflowNeedUpdate := v1alpha1.Workflow{}
+		err := it.kubeClient.Get(ctx, types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, &workflowNeedUpdate)
+		if err != nil {
+			return err
+		}
+		workflowNeedUpdate.Spec.Templates = resolvedTemplates
+		return it.kubeClient.Update(ctx, &workflowNeedUpdate)
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "update templates of workflow %s/%s", workflow.Namespace, workflow.Name)
+	}
+	return true, nil
+}
+
+// markTemplateRefInvalid sets the workflow as not scheduled because of the invalid template references
+func (it *WorkflowEntryReconciler) markTemplateRefInvalid(ctx context.Context, name types.NamespacedName) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		workflowNeedUpdate := v1alpha1.Workflow{}
+		err := it.kubeClient.Get(ctx, name, &workflowNeedUpdate)
+		if err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		SetWorkflowCondition(&workflowNeedUpdate.Status, v1alpha1.WorkflowCondition{
+			Type:   v1alpha1.WorkflowConditionScheduled,
+			Status: corev1.ConditionFalse,
+			Reason: v1alpha1.InvalidTemplateRef,
+		})
+		return it.kubeClient.Status().Update(ctx, &workflowNeedUpdate)
+	})
+}
+
 // spawnEntryNode will create **one** entry workflow node for current workflow
 func (it *WorkflowEntryReconciler) spawnEntryNode(ctx context.Context, workflow v1alpha1.Workflow) (*v1alpha1.WorkflowNode, error) {
 	// This workflow is just created, create entry node
-	nodes, err := renderNodesByTemplates(&workflow, nil, workflow.Spec.Entry)
+	nodes, err := renderNodesByTemplates(&workflow, nil, nil, workflow.Spec.Entry)
 	if err != nil {
 		it.logger.Error(err, "failed create entry node", "workflow", workflow.Name, "entry", workflow.Spec.Entry)
 		return nil, err
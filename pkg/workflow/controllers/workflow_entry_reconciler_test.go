@@ -0,0 +1,122 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// unit tests
+func TestWorkflowEntryReconciler_resolveTemplateRefs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	name := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "workflow"}
+	library := &v1alpha1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "network"},
+		Spec: v1alpha1.WorkflowTemplateSpec{
+			Templates: []v1alpha1.Template{
+				{Name: "delay-twice", Type: v1alpha1.TypeSerial, Children: []string{"delay", "delay"}},
+				{Name: "delay", Type: v1alpha1.TypeSuspend},
+			},
+		},
+	}
+	newWorkflow := func() *v1alpha1.Workflow {
+		return &v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+			Spec: v1alpha1.WorkflowSpec{
+				Entry: "the-fault",
+				Templates: []v1alpha1.Template{{
+					Name:        "the-fault",
+					Type:        v1alpha1.TypeTemplateRef,
+					TemplateRef: &v1alpha1.TemplateRef{Name: "network", Template: "delay-twice"},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		objects       []runtime.Object
+		wantErr       bool
+		wantTemplates []string
+		wantReason    string
+	}{
+		{
+			name:          "resolve the template and its children",
+			objects:       []runtime.Object{newWorkflow(), library},
+			wantTemplates: []string{"the-fault", "delay"},
+		}, {
+			name:          "requeue the missing library",
+			objects:       []runtime.Object{newWorkflow()},
+			wantErr:       true,
+			wantTemplates: []string{"the-fault"},
+			wantReason:    v1alpha1.InvalidTemplateRef,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tt.objects...).Build()
+			eventRecorder := recorder.NewDebugRecorder()
+			reconciler := NewWorkflowEntryReconciler(kubeClient, eventRecorder, logr.Discard())
+
+			_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: name})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			workflow := v1alpha1.Workflow{}
+			if err := kubeClient.Get(context.TODO(), name, &workflow); err != nil {
+				t.Fatal(err)
+			}
+			var templates []string
+			for _, template := range workflow.Spec.Templates {
+				templates = append(templates, template.Name)
+			}
+			if len(templates) != len(tt.wantTemplates) {
+				t.Fatalf("templates = %v, want %v", templates, tt.wantTemplates)
+			}
+			for i := range templates {
+				if templates[i] != tt.wantTemplates[i] {
+					t.Fatalf("templates = %v, want %v", templates, tt.wantTemplates)
+				}
+			}
+
+			if len(tt.wantReason) > 0 {
+				condition := GetWorkflowCondition(workflow.Status, v1alpha1.WorkflowConditionScheduled)
+				if condition == nil || condition.Status != corev1.ConditionFalse || condition.Reason != tt.wantReason {
+					t.Errorf("condition = %v, want reason %s", condition, tt.wantReason)
+				}
+				if len(eventRecorder.Events[name]) != 1 {
+					t.Errorf("events = %v, want one event", eventRecorder.Events[name])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package templateref
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Library identifies the WorkflowTemplate, or the ClusterWorkflowTemplate if ClusterScope is true,
+// referenced by a template with type TemplateRef.
+type Library struct {
+	ClusterScope bool
+	Namespace    string
+	Name         string
+}
+
+// LibraryOf returns the library referenced by the templateRef of a workflow in the namespace.
+func LibraryOf(namespace string, templateRef *v1alpha1.TemplateRef) Library {
+	if templateRef.ClusterScope {
+		return Library{ClusterScope: true, Name: templateRef.Name}
+	}
+	if len(templateRef.Namespace) > 0 {
+		namespace = templateRef.Namespace
+	}
+	return Library{Namespace: namespace, Name: templateRef.Name}
+}
+
+func (it Library) String() string {
+	if it.ClusterScope {
+		return fmt.Sprintf("%s %s", v1alpha1.KindClusterWorkflowTemplate, it.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", v1alpha1.KindWorkflowTemplate, it.Namespace, it.Name)
+}
+
+// InvalidTemplateRefError means the template reference could not be resolved with the current libraries,
+// it would not be fixed by retrying unless the libraries are changed.
+type InvalidTemplateRefError struct {
+	// Template is the name of the template with type TemplateRef in the workflow
+	Template string
+	// TemplateRef is the referenced template
+	TemplateRef string
+	Message     string
+}
+
+func (it *InvalidTemplateRefError) Error() string {
+	return fmt.Sprintf("failed to resolve the reference of template %s to %s: %s", it.Template, it.TemplateRef, it.Message)
+}
+
+// origin is where a resolved template comes from, it's empty for the templates defined in the workflow
+type origin struct {
+	library  Library
+	template string
+}
+
+type resolver struct {
+	ctx       context.Context
+	reader    client.Reader
+	namespace string
+
+	libraries map[Library]*v1alpha1.WorkflowTemplateSpec
+	origins   map[string]origin
+	templates []v1alpha1.Template
+}
+
+// Resolve returns the templates of the workflow, in which the templates with type TemplateRef are replaced by the
+// referenced templates with the local names kept. The templates which are referenced by the children, conditional
+// branches, hooks or outputs of a referenced template are copied from the same library with their own names,
+// transitively. It returns nil if there is no template with type TemplateRef in the workflow.
+func Resolve(ctx context.Context, reader client.Reader, workflow *v1alpha1.Workflow) ([]v1alpha1.Template, error) {
+	r := &resolver{
+		ctx:       ctx,
+		reader:    reader,
+		namespace: workflow.Namespace,
+		libraries: make(map[Library]*v1alpha1.WorkflowTemplateSpec),
+		origins:   make(map[string]origin),
+	}
+
+	hasTemplateRef := false
+	for _, template := range workflow.Spec.Templates {
+		if template.Type == v1alpha1.TypeTemplateRef && template.TemplateRef != nil {
+			hasTemplateRef = true
+			r.origins[template.Name] = origin{
+				library:  LibraryOf(workflow.Namespace, template.TemplateRef),
+				template: template.TemplateRef.Template,
+			}
+			continue
+		}
+		r.origins[template.Name] = origin{}
+	}
+	if !hasTemplateRef {
+		return nil, nil
+	}
+
+	for _, template := range workflow.Spec.Templates {
+		if template.Type != v1alpha1.TypeTemplateRef || template.TemplateRef == nil {
+			r.templates = append(r.templates, template)
+			continue
+		}
+		if err := r.resolve(template); err != nil {
+			return nil, err
+		}
+	}
+	return r.templates, nil
+}
+
+func (it *resolver) resolve(template v1alpha1.Template) error {
+	library := LibraryOf(it.namespace, template.TemplateRef)
+	invalid := func(format string, args ...interface{}) error {
+		return &InvalidTemplateRefError{
+			Template:    template.Name,
+			TemplateRef: fmt.Sprintf("template %s in %s", template.TemplateRef.Template, library),
+			Message:     fmt.Sprintf(format, args...),
+		}
+	}
+
+	spec, err := it.library(library)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return invalid("%s not found", library)
+		}
+		return err
+	}
+	referenced, ok := spec.FindTemplate(template.TemplateRef.Template)
+	if !ok {
+		return invalid("template %s not found", template.TemplateRef.Template)
+	}
+	if referenced.Type == v1alpha1.TypeTemplateRef {
+		return invalid("template %s references other templates", referenced.Name)
+	}
+
+	// keep the name of the local template, so the other templates could still refer to it
+	referenced.Name = template.Name
+	it.templates = append(it.templates, referenced)
+
+	return it.copyReferenced(referenced, library, spec, invalid)
+}
+
+// copyReferenced copies the templates referenced by the template from the library transitively
+func (it *resolver) copyReferenced(template v1alpha1.Template, library Library, spec *v1alpha1.WorkflowTemplateSpec,
+	invalid func(format string, args ...interface{}) error) error {
+	names, err := referencedNames(template)
+	if err != nil {
+		return invalid("%s", err)
+	}
+
+	for _, name := range names {
+		current := origin{library: library, template: name}
+		if existing, ok := it.origins[name]; ok {
+			if existing != current {
+				return invalid("template %s in the library conflicts with the template %s in the workflow", name, name)
+			}
+			// it has been copied, or it will be resolved from the same template in the library
+			continue
+		}
+		it.origins[name] = current
+
+		child, ok := spec.FindTemplate(name)
+		if !ok {
+			return invalid("template %s not found", name)
+		}
+		if child.Type == v1alpha1.TypeTemplateRef {
+			return invalid("template %s references other templates", name)
+		}
+		it.templates = append(it.templates, child)
+
+		if err := it.copyReferenced(child, library, spec, invalid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (it *resolver) library(library Library) (*v1alpha1.WorkflowTemplateSpec, error) {
+	if spec, ok := it.libraries[library]; ok {
+		return spec, nil
+	}
+
+	var spec *v1alpha1.WorkflowTemplateSpec
+	if library.ClusterScope {
+		clusterWorkflowTemplate := v1alpha1.ClusterWorkflowTemplate{}
+		err := it.reader.Get(it.ctx, types.NamespacedName{Name: library.Name}, &clusterWorkflowTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get %s", library)
+		}
+		spec = &clusterWorkflowTemplate.Spec
+	} else {
+		workflowTemplate := v1alpha1.WorkflowTemplate{}
+		err := it.reader.Get(it.ctx, types.NamespacedName{Namespace: library.Namespace, Name: library.Name}, &workflowTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get %s", library)
+		}
+		spec = &workflowTemplate.Spec
+	}
+
+	it.libraries[library] = spec
+	return spec, nil
+}
+
+// referencedNames returns the names of the templates referenced by the template
+func referencedNames(template v1alpha1.Template) ([]string, error) {
+	var names []string
+	names = append(names, template.Children...)
+	for _, branch := range template.ConditionalBranches {
+		names = append(names, branch.Target)
+	}
+	if len(template.OnFailure) > 0 {
+		names = append(names, template.OnFailure)
+	}
+	if len(template.OnExit) > 0 {
+		names = append(names, template.OnExit)
+	}
+
+	outputs, err := v1alpha1.OutputReferences(template)
+	if err != nil {
+		return nil, err
+	}
+	return append(names, outputs...), nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package templateref
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestResolve(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	library := v1alpha1.WorkflowTemplateSpec{
+		Templates: []v1alpha1.Template{
+			{Name: "delay-then-loss", Type: v1alpha1.TypeSerial, Children: []string{"delay", "loss"}, OnFailure: "notify"},
+			{Name: "delay", Type: v1alpha1.TypeSuspend},
+			{Name: "loss", Type: v1alpha1.TypeTask, ConditionalBranches: []v1alpha1.ConditionalBranch{{Target: "delay"}, {Target: "partition"}}},
+			{Name: "partition", Type: v1alpha1.TypeSuspend},
+			{Name: "notify", Type: v1alpha1.TypeSuspend},
+			{Name: "broken", Type: v1alpha1.TypeSerial, Children: []string{"missing"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1alpha1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "chaos-library", Name: "network"},
+			Spec:       library,
+		},
+		&v1alpha1.ClusterWorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "network"},
+			Spec:       library,
+		},
+	).Build()
+
+	ref := func(name string, templateRef v1alpha1.TemplateRef) v1alpha1.Template {
+		return v1alpha1.Template{Name: name, Type: v1alpha1.TypeTemplateRef, TemplateRef: &templateRef}
+	}
+	workflow := func(templates ...v1alpha1.Template) *v1alpha1.Workflow {
+		return &v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "workflow"},
+			Spec: v1alpha1.WorkflowSpec{
+				Entry:     "entry",
+				Templates: append([]v1alpha1.Template{{Name: "entry", Type: v1alpha1.TypeSerial, Children: []string{"the-fault"}}}, templates...),
+			},
+		}
+	}
+	names := func(templates []v1alpha1.Template) []string {
+		var result []string
+		for _, template := range templates {
+			result = append(result, template.Name)
+		}
+		return result
+	}
+
+	t.Run("no template reference", func(t *testing.T) {
+		templates, err := Resolve(context.TODO(), c, workflow(v1alpha1.Template{Name: "the-fault", Type: v1alpha1.TypeSuspend}))
+		g.Expect(err).To(BeNil())
+		g.Expect(templates).To(BeNil())
+	})
+
+	t.Run("resolve the children transitively", func(t *testing.T) {
+		for _, templateRef := range []v1alpha1.TemplateRef{
+			{Namespace: "chaos-library", Name: "network", Template: "delay-then-loss"},
+			{ClusterScope: true, Name: "network", Template: "delay-then-loss"},
+		} {
+			templates, err := Resolve(context.TODO(), c, workflow(ref("the-fault", templateRef)))
+			g.Expect(err).To(BeNil())
+			g.Expect(names(templates)).To(Equal([]string{"entry", "the-fault", "delay", "loss", "partition", "notify"}))
+			g.Expect(templates[1].Type).To(Equal(v1alpha1.TypeSerial))
+			g.Expect(templates[1].Children).To(Equal([]string{"delay", "loss"}))
+		}
+	})
+
+	t.Run("reference the same template twice", func(t *testing.T) {
+		templates, err := Resolve(context.TODO(), c, workflow(
+			ref("the-fault", v1alpha1.TemplateRef{Namespace: "chaos-library", Name: "network", Template: "delay-then-loss"}),
+			ref("delay", v1alpha1.TemplateRef{Namespace: "chaos-library", Name: "network", Template: "delay"}),
+		))
+		g.Expect(err).To(BeNil())
+		g.Expect(names(templates)).To(Equal([]string{"entry", "the-fault", "loss", "partition", "notify", "delay"}))
+	})
+
+	t.Run("default to the namespace of workflow", func(t *testing.T) {
+		_, err := Resolve(context.TODO(), c, workflow(ref("the-fault", v1alpha1.TemplateRef{Name: "network", Template: "delay"})))
+		g.Expect(err).To(BeAssignableToTypeOf(&InvalidTemplateRefError{}))
+		g.Expect(err.Error()).To(ContainSubstring("WorkflowTemplate default/network not found"))
+	})
+
+	t.Run("invalid references", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			templates []v1alpha1.Template
+			expected  string
+		}{
+			{
+				name:      "cluster library not found",
+				templates: []v1alpha1.Template{ref("the-fault", v1alpha1.TemplateRef{ClusterScope: true, Name: "io", Template: "delay"})},
+				expected:  "ClusterWorkflowTemplate io not found",
+			}, {
+				name:      "template not found",
+				templates: []v1alpha1.Template{ref("the-fault", v1alpha1.TemplateRef{Namespace: "chaos-library", Name: "network", Template: "corrupt"})},
+				expected:  "template corrupt not found",
+			}, {
+				name:      "child not found",
+				templates: []v1alpha1.Template{ref("the-fault", v1alpha1.TemplateRef{Namespace: "chaos-library", Name: "network", Template: "broken"})},
+				expected:  "template missing not found",
+			}, {
+				name: "child conflicts with the workflow",
+				templates: []v1alpha1.Template{
+					ref("the-fault", v1alpha1.TemplateRef{Namespace: "chaos-library", Name: "network", Template: "delay-then-loss"}),
+					{Name: "notify", Type: v1alpha1.TypeSuspend},
+				},
+				expected: "template notify in the library conflicts with the template notify in the workflow",
+			},
+		}
+		for _, tc := range testCases {
+			_, err := Resolve(context.TODO(), c, workflow(tc.templates...))
+			g.Expect(err).To(BeAssignableToTypeOf(&InvalidTemplateRefError{}), tc.name)
+			g.Expect(err.Error()).To(ContainSubstring(tc.expected), tc.name)
+		}
+	})
+}
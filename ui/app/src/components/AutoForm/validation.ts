@@ -25,7 +25,7 @@ const scopeInitialValuesSchema = {
 }
 
 export function isInstant(kind: string, action?: string) {
-  if (kind === 'PodChaos' && (action === 'pod-kill' || action === 'container-kill')) {
+  if (kind === 'PodChaos' && (action === 'pod-kill' || action === 'container-kill' || action === 'process-kill')) {
     return true
   }
 
@@ -131,8 +131,11 @@ export interface Network {
 }
 
 export interface Pod {
-  action: 'pod-failure' | 'pod-kill' | 'container-kill'
+  action: 'pod-failure' | 'pod-kill' | 'container-kill' | 'container-pause' | 'process-kill'
   containerNames?: string[]
+  processName?: string
+  processCmdline?: string
+  signal?: number
 }
 
 export interface Stress {
@@ -140,11 +143,33 @@ export interface Stress {
     cpu?: {
       workers: number
       load: number
+      quotaPercent?: number
       options: string[]
     }
     memory?: {
       workers: number
       size: string
+      limitPercent?: number
+      ramp?: {
+        duration?: string
+        step?: string
+        interval?: string
+      }
+      options: string[]
+    }
+    io?: {
+      workers: number
+      options: string[]
+    }
+    hdd?: {
+      workers: number
+      path: string
+      bytes: string
+      options: string[]
+    }
+    sock?: {
+      workers: number
+      port?: number
       options: string[]
     }
   }
@@ -154,6 +179,7 @@ export interface Stress {
 
 export interface Time {
   timeOffset: string
+  clockDriftRate?: string
   clockIds: string[]
   containerNames: string[]
 }
@@ -163,6 +189,7 @@ export interface ExperimentType {
   AzureChaos?: unknown
   DNSChaos: DNS
   GCPChaos: GCP
+  GRPCChaos?: unknown
   HTTPChaos?: unknown
   IOChaos: IO
   JVMChaos?: unknown
@@ -750,6 +750,40 @@ const data: Record<Kind, Definition> = {
           containerNames,
         },
       },
+      {
+        name: 'Container Pause',
+        key: 'container-pause',
+        spec: {
+          action: 'container-pause' as any,
+          containerNames,
+        },
+      },
+      {
+        name: 'Process Kill',
+        key: 'process-kill',
+        spec: {
+          action: 'process-kill' as any,
+          containerNames,
+          processName: {
+            field: 'text',
+            label: 'Process name',
+            value: '',
+            helperText: 'Optional. A regular expression matched against the command name of processes',
+          },
+          processCmdline: {
+            field: 'text',
+            label: 'Process cmdline',
+            value: '',
+            helperText: 'Optional. A regular expression matched against the command line of processes',
+          },
+          signal: {
+            field: 'number',
+            label: 'Signal',
+            value: 9,
+            helperText: 'Optional. The signal sent to the processes, default is 9 (SIGKILL)',
+          },
+        },
+      },
     ],
   },
   // Stress Test
@@ -766,6 +800,20 @@ const data: Record<Kind, Definition> = {
           size: '',
           options: [],
         },
+        io: {
+          workers: 0,
+          options: [],
+        },
+        hdd: {
+          workers: 0,
+          path: '',
+          bytes: '',
+          options: [],
+        },
+        sock: {
+          workers: 0,
+          options: [],
+        },
       },
       stressngStressors: '',
       containerNames: [],
@@ -780,6 +828,12 @@ const data: Record<Kind, Definition> = {
         value: '',
         helperText: 'Fill the time offset',
       },
+      clockDriftRate: {
+        field: 'text',
+        label: 'Drift rate',
+        value: '',
+        helperText: 'Optional. The time offset drifted per second. For example: 50ms',
+      },
       clockIds: {
         field: 'label',
         label: 'Clock ids',
@@ -1332,6 +1386,12 @@ export const dataPhysic: Record<KindPhysic, Definition> = {
         value: '',
         helperText: 'Fill the time offset',
       },
+      clockDriftRate: {
+        field: 'text',
+        label: 'Drift rate',
+        value: '',
+        helperText: 'Optional. The time offset drifted per second. For example: 50ms',
+      },
       pid: {
         field: 'number',
         label: 'Pid',
@@ -1472,6 +1532,9 @@ export const schema: Partial<Record<Kind, Record<string, Yup.ObjectSchema>>> = {
     'container-kill': Yup.object({
       containerNames: Yup.array().of(Yup.string()).required('At least one container name is required'),
     }),
+    'container-pause': Yup.object({
+      containerNames: Yup.array().of(Yup.string()).required('At least one container name is required'),
+    }),
   },
   TimeChaos: {
     default: Yup.object({
@@ -28,7 +28,7 @@ import i18n from 'components/T'
 import { validateDuration, validateSchedule } from 'lib/formikhelpers'
 
 function isInstant(kind: ExperimentKind | '', action: string) {
-  if (kind === 'PodChaos' && (action === 'pod-kill' || action === 'container-kill')) {
+  if (kind === 'PodChaos' && (action === 'pod-kill' || action === 'container-kill' || action === 'process-kill')) {
     return true
   }
 
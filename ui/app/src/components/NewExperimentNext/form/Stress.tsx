@@ -30,20 +30,18 @@ import typesData from '../data/types'
 const validate = (values: any) => {
   let errors = {}
 
-  const { cpu, memory } = values.stressors
+  const stressors = values.stressors
+  const names = Object.keys(stressors)
 
-  if (cpu.workers <= 0 && memory.workers <= 0) {
-    const message = 'The CPU or Memory workers must have at least one greater than 0'
+  if (names.every((name) => !(stressors[name].workers > 0 || stressors[name].quotaPercent > 0))) {
+    const message = 'The workers of at least one stressor or the CPU quota percent must be greater than 0'
 
     errors = {
-      stressors: {
-        cpu: {
-          workers: message,
-        },
-        memory: {
-          workers: message,
-        },
-      },
+      stressors: names.reduce((acc: Record<string, any>, name) => {
+        acc[name] = { workers: message }
+
+        return acc
+      }, {}),
     }
   }
 
@@ -86,6 +84,13 @@ const Stress: React.FC<StressProps> = ({ onSubmit }) => {
               inputProps={{ min: 0 }}
             />
             <TextField type="number" name="stressors.cpu.load" label="Load" helperText="CPU load" />
+            <TextField
+              type="number"
+              name="stressors.cpu.quotaPercent"
+              label="Quota percent"
+              helperText="Optional. The percentage of the CPU quota of the container, the workers and load are computed from it if set"
+              inputProps={{ min: 0, max: 100 }}
+            />
             <LabelField
               name="stressors.cpu.options"
               label="Options of CPU stressors"
@@ -108,11 +113,98 @@ const Stress: React.FC<StressProps> = ({ onSubmit }) => {
               label="Size"
               helperText="Memory size specifies the memory size to be occupied or a percentage of the total memory size"
             />
+            <TextField
+              type="number"
+              name="stressors.memory.limitPercent"
+              label="Limit percent"
+              helperText="Optional. The percentage of the memory limit of the container, it conflicts with the size"
+              inputProps={{ min: 0, max: 100 }}
+            />
+            <TextField
+              name="stressors.memory.ramp.duration"
+              label="Ramp duration"
+              helperText="Optional. The time to grow the memory to the size"
+            />
+            <TextField
+              name="stressors.memory.ramp.step"
+              label="Ramp step"
+              helperText="Optional. The memory size to grow every interval, e.g. 100MB"
+            />
+            <TextField
+              name="stressors.memory.ramp.interval"
+              label="Ramp interval"
+              helperText="Optional. The interval between two steps, e.g. 1m"
+            />
             <LabelField
               name="stressors.memory.options"
               label="Options of Memory stressors"
               helperText="Type and end with Enter to generate the memStress options"
             />
+
+            <Typography>I/O</Typography>
+            <TextField
+              type="number"
+              name="stressors.io.workers"
+              label="Workers"
+              helperText={getIn(errors, 'stressors.io.workers') ? getIn(errors, 'stressors.io.workers') : 'I/O workers'}
+              error={getIn(errors, 'stressors.io.workers') ? true : false}
+              inputProps={{ min: 0 }}
+            />
+            <LabelField
+              name="stressors.io.options"
+              label="Options of I/O stressors"
+              helperText="Type and end with Enter to generate the stress-ng options"
+            />
+
+            <Typography>HDD</Typography>
+            <TextField
+              type="number"
+              name="stressors.hdd.workers"
+              label="Workers"
+              helperText={
+                getIn(errors, 'stressors.hdd.workers') ? getIn(errors, 'stressors.hdd.workers') : 'HDD workers'
+              }
+              error={getIn(errors, 'stressors.hdd.workers') ? true : false}
+              inputProps={{ min: 0 }}
+            />
+            <TextField
+              name="stressors.hdd.path"
+              label="Path"
+              helperText="The directory in the container to write the temporary files, default is /tmp"
+            />
+            <TextField
+              name="stressors.hdd.bytes"
+              label="Bytes"
+              helperText="The bytes written per worker or a percentage of the free space of the file system"
+            />
+            <LabelField
+              name="stressors.hdd.options"
+              label="Options of HDD stressors"
+              helperText="Type and end with Enter to generate the stress-ng options"
+            />
+
+            <Typography>Socket</Typography>
+            <TextField
+              type="number"
+              name="stressors.sock.workers"
+              label="Workers"
+              helperText={
+                getIn(errors, 'stressors.sock.workers') ? getIn(errors, 'stressors.sock.workers') : 'Socket workers'
+              }
+              error={getIn(errors, 'stressors.sock.workers') ? true : false}
+              inputProps={{ min: 0 }}
+            />
+            <TextField
+              type="number"
+              name="stressors.sock.port"
+              label="Port"
+              helperText="The start port of the socket workers, default is 5000"
+            />
+            <LabelField
+              name="stressors.sock.options"
+              label="Options of Socket stressors"
+              helperText="Type and end with Enter to generate the stress-ng options"
+            />
           </Space>
 
           <MoreOptions>
@@ -31,6 +31,7 @@ export enum ExperimentKind {
   BlockChaos = 'BlockChaos',
   DNSChaos = 'DNSChaos',
   GCPChaos = 'GCPChaos',
+  GRPCChaos = 'GRPCChaos',
   HTTPChaos = 'HTTPChaos',
   IOChaos = 'IOChaos',
   JVMChaos = 'JVMChaos',
@@ -48,6 +49,7 @@ const mapping = new Map<ExperimentKind, string>([
   [ExperimentKind.BlockChaos, 'blockChaos'],
   [ExperimentKind.DNSChaos, 'dnsChaos'],
   [ExperimentKind.GCPChaos, 'gcpChaos'],
+  [ExperimentKind.GRPCChaos, 'grpcChaos'],
   [ExperimentKind.HTTPChaos, 'httpChaos'],
   [ExperimentKind.IOChaos, 'ioChaos'],
   [ExperimentKind.JVMChaos, 'jvmChaos'],
@@ -203,7 +203,7 @@ const IO = ({ data }: any) => (
 
 export const Stress = ({ data: { stressors, stressngStressors, containerName } }: any) => (
   <>
-    {stressors.cpu && stressors.cpu.workers > 0 && (
+    {stressors.cpu && (stressors.cpu.workers > 0 || stressors.cpu.quotaPercent > 0) && (
       <TableRow>
         <TableCell>CPU</TableCell>
         <TableCell>
@@ -213,6 +213,11 @@ export const Stress = ({ data: { stressors, stressngStressors, containerName } }
           <Typography variant="body2" color="textSecondary">
             size: {stressors.cpu.size}
           </Typography>
+          {stressors.cpu.quotaPercent && (
+            <Typography variant="body2" color="textSecondary">
+              quotaPercent: {stressors.cpu.quotaPercent}
+            </Typography>
+          )}
         </TableCell>
       </TableRow>
     )}
@@ -226,6 +231,63 @@ export const Stress = ({ data: { stressors, stressngStressors, containerName } }
           <Typography variant="body2" color="textSecondary">
             size: {stressors.memory.size}
           </Typography>
+          {stressors.memory.limitPercent && (
+            <Typography variant="body2" color="textSecondary">
+              limitPercent: {stressors.memory.limitPercent}
+            </Typography>
+          )}
+          {stressors.memory.ramp && (
+            <Typography variant="body2" color="textSecondary">
+              ramp:{' '}
+              {stressors.memory.ramp.duration ||
+                `${stressors.memory.ramp.step} every ${stressors.memory.ramp.interval}`}
+            </Typography>
+          )}
+        </TableCell>
+      </TableRow>
+    )}
+    {stressors.io && stressors.io.workers > 0 && (
+      <TableRow>
+        <TableCell>I/O</TableCell>
+        <TableCell>
+          <Typography variant="body2" color="textSecondary">
+            workers: {stressors.io.workers}
+          </Typography>
+        </TableCell>
+      </TableRow>
+    )}
+    {stressors.hdd && stressors.hdd.workers > 0 && (
+      <TableRow>
+        <TableCell>HDD</TableCell>
+        <TableCell>
+          <Typography variant="body2" color="textSecondary">
+            workers: {stressors.hdd.workers}
+          </Typography>
+          {stressors.hdd.path && (
+            <Typography variant="body2" color="textSecondary">
+              path: {stressors.hdd.path}
+            </Typography>
+          )}
+          {stressors.hdd.bytes && (
+            <Typography variant="body2" color="textSecondary">
+              bytes: {stressors.hdd.bytes}
+            </Typography>
+          )}
+        </TableCell>
+      </TableRow>
+    )}
+    {stressors.sock && stressors.sock.workers > 0 && (
+      <TableRow>
+        <TableCell>Socket</TableCell>
+        <TableCell>
+          <Typography variant="body2" color="textSecondary">
+            workers: {stressors.sock.workers}
+          </Typography>
+          {stressors.sock.port && (
+            <Typography variant="body2" color="textSecondary">
+              port: {stressors.sock.port}
+            </Typography>
+          )}
         </TableCell>
       </TableRow>
     )}
@@ -270,6 +332,16 @@ const Time = ({ data }: any) => (
         </TableCell>
       </TableRow>
     )}
+    {data.clockDriftRate && (
+      <TableRow>
+        <TableCell>Clock drift rate</TableCell>
+        <TableCell>
+          <Typography variant="body2" color="textSecondary">
+            {data.clockDriftRate}
+          </Typography>
+        </TableCell>
+      </TableRow>
+    )}
     {data.clockIds && (
       <TableRow>
         <TableCell>Clock ids</TableCell>
@@ -3,7 +3,7 @@
  * Do not make direct changes to the file.
  */
 
-export const actions = ['pod-kill', 'pod-failure', 'container-kill'],
+export const actions = ['pod-kill', 'pod-failure', 'container-kill', 'container-pause', 'process-kill'],
   data = [
     {
       field: 'label',
@@ -19,4 +19,25 @@ export const actions = ['pod-kill', 'pod-failure', 'container-kill'],
       helperText:
         'Optional. GracePeriod is used in pod-kill action. It represents the duration in seconds before the pod should be deleted. Value must be non-negative integer. The default value is zero that indicates delete immediately.',
     },
+    {
+      field: 'text',
+      label: 'processCmdline',
+      value: '',
+      helperText:
+        'Optional. ProcessCmdline is a regular expression matched against the command line of the processes in the container, which is used in process-kill action. If both ProcessName and ProcessCmdline are set, a process must match both of them.',
+    },
+    {
+      field: 'text',
+      label: 'processName',
+      value: '',
+      helperText:
+        'Optional. ProcessName is a regular expression matched against the command name of the processes in the container, which is used in process-kill action.',
+    },
+    {
+      field: 'number',
+      label: 'signal',
+      value: 0,
+      helperText:
+        'Optional. Signal is the signal number sent to the processes in process-kill action. The default value is 9 (SIGKILL).',
+    },
   ]
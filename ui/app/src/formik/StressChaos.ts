@@ -34,6 +34,65 @@ export const actions = [],
               helperText:
                 'Optional. Load specifies P percent loading per CPU worker. 0 is effectively a sleep (no load) and 100 is full loading.',
             },
+            {
+              field: 'label',
+              label: 'options',
+              value: [],
+              helperText: 'Optional. extend stress-ng options',
+            },
+            {
+              field: 'number',
+              label: 'quotaPercent',
+              value: 0,
+              helperText:
+                "Optional. QuotaPercent specifies the stressors to consume P percent of the cpu quota of the container's cgroup. The workers and load are computed by chaos-daemon from the quota when it's set, and the Workers and Load specified here are ignored.",
+            },
+            {
+              field: 'number',
+              label: 'workers',
+              value: 0,
+              helperText:
+                'Workers specifies N workers to apply the stressor. Maximum 8192 workers can run by stress-ng +kubebuilder:validation:Maximum=8192',
+            },
+          ],
+        },
+        {
+          field: 'ref',
+          label: 'hdd',
+          children: [
+            {
+              field: 'text',
+              label: 'bytes',
+              value: '',
+              helperText:
+                'Optional. Bytes specifies N bytes written per hdd worker, default is 1GB. One can specify the size as % of free space on the file system or in units of B, KB/KiB, MB/MiB, GB/GiB, TB/TiB.',
+            },
+            {
+              field: 'label',
+              label: 'options',
+              value: [],
+              helperText: 'Optional. extend stress-ng options',
+            },
+            {
+              field: 'text',
+              label: 'path',
+              value: '',
+              helperText:
+                'Optional. Path specifies the directory in the target container to write the temporary files.',
+            },
+            {
+              field: 'number',
+              label: 'workers',
+              value: 0,
+              helperText:
+                'Workers specifies N workers to apply the stressor. Maximum 8192 workers can run by stress-ng +kubebuilder:validation:Maximum=8192',
+            },
+          ],
+        },
+        {
+          field: 'ref',
+          label: 'io',
+          children: [
             {
               field: 'label',
               label: 'options',
@@ -53,6 +112,13 @@ export const actions = [],
           field: 'ref',
           label: 'memory',
           children: [
+            {
+              field: 'number',
+              label: 'limitPercent',
+              value: 0,
+              helperText:
+                "Optional. LimitPercent specifies the size as P percent of the memory limit of the container's cgroup, which is computed by chaos-daemon. It conflicts with Size.",
+            },
             {
               field: 'number',
               label: 'oomScoreAdj',
@@ -66,6 +132,32 @@ export const actions = [],
               value: [],
               helperText: 'Optional. extend stress-ng options',
             },
+            {
+              field: 'ref',
+              label: 'ramp',
+              children: [
+                {
+                  field: 'text',
+                  label: 'duration',
+                  value: '',
+                  helperText:
+                    'Optional. Duration specifies the time to grow the memory consumption to the size. It conflicts with Step and Interval.',
+                },
+                {
+                  field: 'text',
+                  label: 'interval',
+                  value: '',
+                  helperText: 'Optional. Interval specifies the interval between two steps.',
+                },
+                {
+                  field: 'text',
+                  label: 'step',
+                  value: '',
+                  helperText:
+                    'Optional. Step specifies the bytes to grow every Interval, in units of B, KB/KiB, MB/MiB, GB/GiB, TB/TiB.',
+                },
+              ],
+            },
             {
               field: 'text',
               label: 'size',
@@ -82,6 +174,32 @@ export const actions = [],
             },
           ],
         },
+        {
+          field: 'ref',
+          label: 'sock',
+          children: [
+            {
+              field: 'label',
+              label: 'options',
+              value: [],
+              helperText: 'Optional. extend stress-ng options',
+            },
+            {
+              field: 'number',
+              label: 'port',
+              value: 0,
+              helperText:
+                'Optional. Port specifies the start port of the sock workers, every worker uses one port, default is 5000.',
+            },
+            {
+              field: 'number',
+              label: 'workers',
+              value: 0,
+              helperText:
+                'Workers specifies N workers to apply the stressor. Maximum 8192 workers can run by stress-ng +kubebuilder:validation:Maximum=8192',
+            },
+          ],
+        },
       ],
     },
   ]
@@ -5,6 +5,13 @@
 
 export const actions = [],
   data = [
+    {
+      field: 'text',
+      label: 'clockDriftRate',
+      value: '',
+      helperText:
+        'Optional. ClockDriftRate defines the delta time drifted per second of injected program, so the offset grows during the experiment. It\'s a possibly signed sequence of decimal numbers less than one second, such as "50ms" or "-1.5ms". For example, with timeOffset "0s" and clockDriftRate "50ms", the clock is 3s ahead after one minute.',
+    },
     {
       field: 'label',
       label: 'clockIds',
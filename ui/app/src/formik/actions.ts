@@ -53,7 +53,7 @@ const actions = {
     'vm',
     'user_defined',
   ],
-  PodChaos: ['pod-kill', 'pod-failure', 'container-kill'],
+  PodChaos: ['pod-kill', 'pod-failure', 'container-kill', 'container-pause', 'process-kill'],
   StressChaos: [],
   TimeChaos: [],
 }
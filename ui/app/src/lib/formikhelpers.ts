@@ -125,6 +125,33 @@ export function parseSubmit<K extends ExperimentKind>(
     ;(spec as any).attr = arrToObjBySep((spec as any).attr, ':', { updateVal: (s: string) => parseInt(s, 10) })
   }
 
+  if (kind === 'StressChaos') {
+    // Remove the stressors without workers
+    const stressors = (spec as any).stressors || {}
+
+    Object.keys(stressors).forEach((k) => {
+      if (!(stressors[k].workers > 0 || stressors[k].quotaPercent > 0)) {
+        delete stressors[k]
+      }
+    })
+
+    if (stressors.cpu && !stressors.cpu.quotaPercent) {
+      delete stressors.cpu.quotaPercent
+    }
+
+    const memory = stressors.memory
+
+    if (memory) {
+      if (!memory.limitPercent) {
+        delete memory.limitPercent
+      }
+
+      if (memory.ramp && !memory.ramp.duration && !memory.ramp.step) {
+        delete memory.ramp
+      }
+    }
+  }
+
   if (kind === 'HTTPChaos') {
     // Parse http headers to object
     function helperHTTPHeaders(selectors: string[]) {
@@ -327,6 +354,26 @@ export function parseYAML(yamlObj: any): { kind: ExperimentKind; basic: any; spe
       options: [],
       ...spec.stressors.memory,
     }
+
+    spec.stressors.io = {
+      workers: 0,
+      options: [],
+      ...spec.stressors.io,
+    }
+
+    spec.stressors.hdd = {
+      workers: 0,
+      path: '',
+      bytes: '',
+      options: [],
+      ...spec.stressors.hdd,
+    }
+
+    spec.stressors.sock = {
+      workers: 0,
+      options: [],
+      ...spec.stressors.sock,
+    }
   }
 
   if (kind === 'PhysicalMachineChaos') {
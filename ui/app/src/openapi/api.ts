@@ -3698,6 +3698,25 @@ export interface V1alpha1AWSChaosSpec {
    */
   volumeID?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1Argument
+ */
+export interface V1alpha1Argument {
+  /**
+   *
+   * @type {string}
+   * @memberof V1alpha1Argument
+   */
+  name?: string
+  /**
+   *
+   * @type {string}
+   * @memberof V1alpha1Argument
+   */
+  value?: string
+}
 /**
  *
  * @export
@@ -3967,6 +3986,12 @@ export interface V1alpha1CPUStressor {
    * @memberof V1alpha1CPUStressor
    */
   options?: Array<string>
+  /**
+   * QuotaPercent specifies the stressors to consume P percent of the cpu quota of the container\'s cgroup. The workers and load are computed by chaos-daemon from the quota when it\'s set, so it conflicts with Workers and Load. +kubebuilder:validation:Minimum=1 +kubebuilder:validation:Maximum=100 +optional
+   * @type {number}
+   * @memberof V1alpha1CPUStressor
+   */
+  quotaPercent?: number
   /**
    * Workers specifies N workers to apply the stressor. Maximum 8192 workers can run by stress-ng +kubebuilder:validation:Maximum=8192
    * @type {number}
@@ -4550,6 +4575,37 @@ export interface V1alpha1GCPChaosSpec {
    */
   zone?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1HDDStressor
+ */
+export interface V1alpha1HDDStressor {
+  /**
+   * Bytes specifies N bytes written per hdd worker, default is 1GB. One can specify the size as % of free space on the file system or in units of B, KB/KiB, MB/MiB, GB/GiB, TB/TiB. +optional
+   * @type {string}
+   * @memberof V1alpha1HDDStressor
+   */
+  bytes?: string
+  /**
+   * extend stress-ng options +optional
+   * @type {Array<string>}
+   * @memberof V1alpha1HDDStressor
+   */
+  options?: Array<string>
+  /**
+   * Path specifies the directory in the target container to write the temporary files. +kubebuilder:default=/tmp +optional
+   * @type {string}
+   * @memberof V1alpha1HDDStressor
+   */
+  path?: string
+  /**
+   * Workers specifies N workers to apply the stressor. Maximum 8192 workers can run by stress-ng +kubebuilder:validation:Maximum=8192
+   * @type {number}
+   * @memberof V1alpha1HDDStressor
+   */
+  workers?: number
+}
 /**
  *
  * @export
@@ -4648,7 +4704,7 @@ export interface V1alpha1HTTPChaosSpec {
    */
   path?: string
   /**
-   * Port represents the target port to be proxy of.
+   * Port represents the target port to be proxy of. The port cannot be injected by GRPCChaos at the same time.
    * @type {number}
    * @memberof V1alpha1HTTPChaosSpec
    */
@@ -4918,6 +4974,25 @@ export interface V1alpha1IOChaosSpec {
    */
   volumePath?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1IOStressor
+ */
+export interface V1alpha1IOStressor {
+  /**
+   * extend stress-ng options +optional
+   * @type {Array<string>}
+   * @memberof V1alpha1IOStressor
+   */
+  options?: Array<string>
+  /**
+   * Workers specifies N workers to apply the stressor. Maximum 8192 workers can run by stress-ng +kubebuilder:validation:Maximum=8192
+   * @type {number}
+   * @memberof V1alpha1IOStressor
+   */
+  workers?: number
+}
 /**
  *
  * @export
@@ -5409,6 +5484,31 @@ export interface V1alpha1KernelChaosSpec {
    */
   value?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1LoopSpec
+ */
+export interface V1alpha1LoopSpec {
+  /**
+   * Count is the max number of iterations. +optional +kubebuilder:validation:Minimum=1
+   * @type {number}
+   * @memberof V1alpha1LoopSpec
+   */
+  count?: number
+  /**
+   * Interval is the delay between two iterations. +optional
+   * @type {string}
+   * @memberof V1alpha1LoopSpec
+   */
+  interval?: string
+  /**
+   * Until is the expression evaluated after each iteration, expected type of result is boolean. The loop stops once it is evaluated as true. The number of finished iterations is available as "iteration", and the context of the last Task in the iteration (like "exitCode" and "stdout") is also available. The loop node fails if the expression cannot be evaluated. +optional
+   * @type {string}
+   * @memberof V1alpha1LoopSpec
+   */
+  until?: string
+}
 /**
  *
  * @export
@@ -5428,12 +5528,43 @@ export interface V1alpha1LossSpec {
    */
   loss?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1MemoryRamp
+ */
+export interface V1alpha1MemoryRamp {
+  /**
+   * Duration specifies the time to grow the memory consumption to the size. It conflicts with Step and Interval. +optional
+   * @type {string}
+   * @memberof V1alpha1MemoryRamp
+   */
+  duration?: string
+  /**
+   * Interval specifies the interval between two steps. +optional
+   * @type {string}
+   * @memberof V1alpha1MemoryRamp
+   */
+  interval?: string
+  /**
+   * Step specifies the bytes to grow every Interval, in units of B, KB/KiB, MB/MiB, GB/GiB, TB/TiB. +optional
+   * @type {string}
+   * @memberof V1alpha1MemoryRamp
+   */
+  step?: string
+}
 /**
  *
  * @export
  * @interface V1alpha1MemoryStressor
  */
 export interface V1alpha1MemoryStressor {
+  /**
+   * LimitPercent specifies the size as P percent of the memory limit of the container\'s cgroup, which is computed by chaos-daemon. It conflicts with Size. +kubebuilder:validation:Minimum=1 +kubebuilder:validation:Maximum=100 +optional
+   * @type {number}
+   * @memberof V1alpha1MemoryStressor
+   */
+  limitPercent?: number
   /**
    * OOMScoreAdj sets the oom_score_adj of the stress process. See `man 5 proc` to know more about this option. +kubebuilder:validation:Minimum=-1000 +kubebuilder:validation:Maximum=1000 +kubebuilder:default=0 +optional
    * @type {number}
@@ -5446,6 +5577,12 @@ export interface V1alpha1MemoryStressor {
    * @memberof V1alpha1MemoryStressor
    */
   options?: Array<string>
+  /**
+   * Ramp specifies how to grow the memory consumption to the size gradually. The memory is allocated immediately if it\'s not set. +optional
+   * @type {V1alpha1MemoryRamp}
+   * @memberof V1alpha1MemoryStressor
+   */
+  ramp?: V1alpha1MemoryRamp
   /**
    * Size specifies N bytes consumed per vm worker, default is the total available memory. One can specify the size as % of total available memory or in units of B, KB/KiB, MB/MiB, GB/GiB, TB/TiB. +optional
    * @type {string}
@@ -6047,6 +6184,31 @@ export interface V1alpha1PMJVMMySQLSpec {
    */
   table?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1Parameter
+ */
+export interface V1alpha1Parameter {
+  /**
+   * Default is used when the parameter is not given in the arguments, the parameter is required if it has no default value. +optional
+   * @type {string}
+   * @memberof V1alpha1Parameter
+   */
+  default?: string
+  /**
+   * +optional
+   * @type {string}
+   * @memberof V1alpha1Parameter
+   */
+  description?: string
+  /**
+   *
+   * @type {string}
+   * @memberof V1alpha1Parameter
+   */
+  name?: string
+}
 /**
  *
  * @export
@@ -6392,7 +6554,7 @@ export interface V1alpha1PhysicalMachineSelectorSpec {
  */
 export interface V1alpha1PodChaosSpec {
   /**
-   * Action defines the specific pod chaos action. Supported action: pod-kill / pod-failure / container-kill Default action: pod-kill +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill
+   * Action defines the specific pod chaos action. Supported action: pod-kill / pod-failure / container-kill / container-pause / process-kill Default action: pod-kill +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill;container-pause;process-kill
    * @type {string}
    * @memberof V1alpha1PodChaosSpec
    */
@@ -6421,12 +6583,30 @@ export interface V1alpha1PodChaosSpec {
    * @memberof V1alpha1PodChaosSpec
    */
   mode?: string
+  /**
+   * ProcessCmdline is a regular expression matched against the command line of the processes in the container, which is used in process-kill action. If both ProcessName and ProcessCmdline are set, a process must match both of them. +optional
+   * @type {string}
+   * @memberof V1alpha1PodChaosSpec
+   */
+  processCmdline?: string
+  /**
+   * ProcessName is a regular expression matched against the command name of the processes in the container, which is used in process-kill action. +optional
+   * @type {string}
+   * @memberof V1alpha1PodChaosSpec
+   */
+  processName?: string
   /**
    *
    * @type {V1alpha1PodSelectorSpec}
    * @memberof V1alpha1PodChaosSpec
    */
   selector?: V1alpha1PodSelectorSpec
+  /**
+   * Signal is the signal number sent to the processes in process-kill action. The default value is 9 (SIGKILL). +optional +kubebuilder:validation:Minimum=1 +kubebuilder:validation:Maximum=64
+   * @type {number}
+   * @memberof V1alpha1PodChaosSpec
+   */
+  signal?: number
   /**
    * Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`. If `FixedMode`, provide an integer of pods to do chaos action. If `FixedPercentMode`, provide a number from 0-100 to specify the percent of pods the server can do chaos action. IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max percent of pods to do chaos action +optional
    * @type {string}
@@ -6818,6 +6998,25 @@ export interface V1alpha1ReorderSpec {
    */
   reorder?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1RetryStrategy
+ */
+export interface V1alpha1RetryStrategy {
+  /**
+   * Backoff is the duration to wait before the first retry, and it is doubled for each of the following retries. +optional
+   * @type {string}
+   * @memberof V1alpha1RetryStrategy
+   */
+  backoff?: string
+  /**
+   * Limit is the max number of retries. +kubebuilder:validation:Minimum=0
+   * @type {number}
+   * @memberof V1alpha1RetryStrategy
+   */
+  limit?: number
+}
 /**
  *
  * @export
@@ -7091,6 +7290,31 @@ export interface V1alpha1ScheduleStatus {
    */
   time?: string
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1SockStressor
+ */
+export interface V1alpha1SockStressor {
+  /**
+   * extend stress-ng options +optional
+   * @type {Array<string>}
+   * @memberof V1alpha1SockStressor
+   */
+  options?: Array<string>
+  /**
+   * Port specifies the start port of the sock workers, every worker uses one port, default is 5000. +kubebuilder:validation:Minimum=1024 +kubebuilder:validation:Maximum=65535 +optional
+   * @type {number}
+   * @memberof V1alpha1SockStressor
+   */
+  port?: number
+  /**
+   * Workers specifies N workers to apply the stressor. Maximum 8192 workers can run by stress-ng +kubebuilder:validation:Maximum=8192
+   * @type {number}
+   * @memberof V1alpha1SockStressor
+   */
+  workers?: number
+}
 /**
  *
  * @export
@@ -7318,12 +7542,30 @@ export interface V1alpha1Stressors {
    * @memberof V1alpha1Stressors
    */
   cpu?: V1alpha1CPUStressor
+  /**
+   *
+   * @type {V1alpha1HDDStressor}
+   * @memberof V1alpha1Stressors
+   */
+  hdd?: V1alpha1HDDStressor
+  /**
+   *
+   * @type {V1alpha1IOStressor}
+   * @memberof V1alpha1Stressors
+   */
+  io?: V1alpha1IOStressor
   /**
    *
    * @type {V1alpha1MemoryStressor}
    * @memberof V1alpha1Stressors
    */
   memory?: V1alpha1MemoryStressor
+  /**
+   *
+   * @type {V1alpha1SockStressor}
+   * @memberof V1alpha1Stressors
+   */
+  sock?: V1alpha1SockStressor
 }
 /**
  *
@@ -7375,7 +7617,7 @@ export interface V1alpha1Template {
    */
   blockChaos?: V1alpha1BlockChaosSpec
   /**
-   * Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel. +optional
+   * Children describes the children steps of serial, parallel or loop node. Only used when Type is TypeSerial, TypeParallel or TypeLoop. +optional
    * @type {Array<string>}
    * @memberof V1alpha1Template
    */
@@ -7428,6 +7670,12 @@ export interface V1alpha1Template {
    * @memberof V1alpha1Template
    */
   kernelChaos?: V1alpha1KernelChaosSpec
+  /**
+   * Loop describes how many times the children of loop node are repeated. Only used when Type is TypeLoop. +optional
+   * @type {V1alpha1LoopSpec}
+   * @memberof V1alpha1Template
+   */
+  loop?: V1alpha1LoopSpec
   /**
    *
    * @type {string}
@@ -7440,6 +7688,18 @@ export interface V1alpha1Template {
    * @memberof V1alpha1Template
    */
   networkChaos?: V1alpha1NetworkChaosSpec
+  /**
+   * OnExit is the name of the template to spawn once the node finishes, no matter whether it fails. Only used when Type is TypeTask, TypeSchedule, TypeSerial, TypeParallel or Type<Something>Chaos. +optional
+   * @type {string}
+   * @memberof V1alpha1Template
+   */
+  onExit?: string
+  /**
+   * OnFailure is the name of the template to spawn once the node fails and there are no more retries, a Serial or Parallel node fails once any of its children fails. Only used when Type is TypeTask, TypeSchedule, TypeSerial, TypeParallel or Type<Something>Chaos. +optional
+   * @type {string}
+   * @memberof V1alpha1Template
+   */
+  onFailure?: string
   /**
    *
    * @type {V1alpha1PhysicalMachineChaosSpec}
@@ -7452,6 +7712,12 @@ export interface V1alpha1Template {
    * @memberof V1alpha1Template
    */
   podChaos?: V1alpha1PodChaosSpec
+  /**
+   * RetryStrategy describes how to retry the node when it fails. Only used when Type is TypeTask, TypeSchedule or Type<Something>Chaos. +optional
+   * @type {V1alpha1RetryStrategy}
+   * @memberof V1alpha1Template
+   */
+  retryStrategy?: V1alpha1RetryStrategy
   /**
    *
    * @type {V1alpha1ChaosOnlyScheduleSpec}
@@ -7476,6 +7742,12 @@ export interface V1alpha1Template {
    * @memberof V1alpha1Template
    */
   task?: V1alpha1Task
+  /**
+   * TemplateRef references a template in a WorkflowTemplate, which is resolved when the workflow starts. Only used when Type is TypeTemplateRef. +optional
+   * @type {V1alpha1TemplateRef}
+   * @memberof V1alpha1Template
+   */
+  templateRef?: V1alpha1TemplateRef
   /**
    *
    * @type {string}
@@ -7489,12 +7761,49 @@ export interface V1alpha1Template {
    */
   timeChaos?: V1alpha1TimeChaosSpec
 }
+/**
+ *
+ * @export
+ * @interface V1alpha1TemplateRef
+ */
+export interface V1alpha1TemplateRef {
+  /**
+   * ClusterScope indicates the template is referenced from a ClusterWorkflowTemplate. +optional
+   * @type {boolean}
+   * @memberof V1alpha1TemplateRef
+   */
+  clusterScope?: boolean
+  /**
+   * Name is the name of the WorkflowTemplate, or the ClusterWorkflowTemplate if ClusterScope is true
+   * @type {string}
+   * @memberof V1alpha1TemplateRef
+   */
+  name?: string
+  /**
+   * Namespace is the namespace of the WorkflowTemplate, default is the namespace of the Workflow. It should be empty if ClusterScope is true. +optional
+   * @type {string}
+   * @memberof V1alpha1TemplateRef
+   */
+  namespace?: string
+  /**
+   * Template is the name of the template in the WorkflowTemplate
+   * @type {string}
+   * @memberof V1alpha1TemplateRef
+   */
+  template?: string
+}
 /**
  *
  * @export
  * @interface V1alpha1TimeChaosSpec
  */
 export interface V1alpha1TimeChaosSpec {
+  /**
+   * ClockDriftRate defines the delta time drifted per second of injected program, so the offset grows during the experiment. It's a possibly signed sequence of decimal numbers less than one second, such as \"50ms\" or \"-1.5ms\". For example, with timeOffset \"0s\" and clockDriftRate \"50ms\", the clock is 3s ahead after one minute. +optional
+   * @type {string}
+   * @memberof V1alpha1TimeChaosSpec
+   */
+  clockDriftRate?: string
   /**
    * ClockIds defines all affected clock id All available options are [\"CLOCK_REALTIME\",\"CLOCK_MONOTONIC\",\"CLOCK_PROCESS_CPUTIME_ID\",\"CLOCK_THREAD_CPUTIME_ID\", \"CLOCK_MONOTONIC_RAW\",\"CLOCK_REALTIME_COARSE\",\"CLOCK_MONOTONIC_COARSE\",\"CLOCK_BOOTTIME\",\"CLOCK_REALTIME_ALARM\", \"CLOCK_BOOTTIME_ALARM\"] Default value is [\"CLOCK_REALTIME\"]
    * @type {Array<string>}
@@ -7753,12 +8062,24 @@ export interface V1alpha1WorkflowCondition {
  * @interface V1alpha1WorkflowSpec
  */
 export interface V1alpha1WorkflowSpec {
+  /**
+   * Arguments gives the values of the parameters for this workflow. +optional
+   * @type {Array<V1alpha1Argument>}
+   * @memberof V1alpha1WorkflowSpec
+   */
+  arguments?: Array<V1alpha1Argument>
   /**
    *
    * @type {string}
    * @memberof V1alpha1WorkflowSpec
    */
   entry?: string
+  /**
+   * Parameters declares the parameters of the workflow, which could be referenced as "{{workflow.parameters.<name>}}" in the templates. +optional
+   * @type {Array<V1alpha1Parameter>}
+   * @memberof V1alpha1WorkflowSpec
+   */
+  parameters?: Array<V1alpha1Parameter>
   /**
    *
    * @type {Array<V1alpha1Template>}